@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var annotateAtFlag string
+
+var annotateCmd = &cobra.Command{
+	Use:   "annotate <content>",
+	Short: "Create a project annotation, e.g. for a deploy",
+	Long: `Create a project-wide annotation. Annotations are shown on PostHog charts
+and on the time axis of lazyhog's trend charts, and are listed in the
+Annotations view.
+
+The annotation is placed at the current time unless --at is given, as
+RFC 3339 ("2024-03-05T14:30:00Z") or local time ("2024-03-05 14:30").`,
+	Example: `  lazyhog annotate "Deployed v2.3"
+  lazyhog annotate --at "2024-03-05 14:30" "Pricing page launched"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAnnotate,
+}
+
+func init() {
+	rootCmd.AddCommand(annotateCmd)
+	annotateCmd.Flags().StringVar(&annotateAtFlag, "at", "", "Time of the annotation (default now)")
+}
+
+func runAnnotate(cmd *cobra.Command, args []string) error {
+	content := strings.TrimSpace(args[0])
+	if content == "" {
+		return fmt.Errorf("annotation content is required")
+	}
+
+	date := time.Now()
+	if annotateAtFlag != "" {
+		var err error
+		date, err = parseAnnotationTime(annotateAtFlag)
+		if err != nil {
+			return err
+		}
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	annotation, err := c.CreateAnnotation(ctx, content, date)
+	if err != nil {
+		return fmt.Errorf("failed to create annotation: %w", err)
+	}
+
+	fmt.Printf("Created annotation #%d at %s: %s\n",
+		annotation.ID, client.FormatEventTime(annotation.DateMarker.Local()), annotation.Content)
+	return nil
+}
+
+// parseAnnotationTime parses an RFC 3339 timestamp, or a local date with an
+// optional time of day
+func parseAnnotationTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid --at time %q: use RFC 3339 or \"2006-01-02 15:04\"", s)
+}
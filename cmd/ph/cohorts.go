@@ -0,0 +1,17 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var cohortsCmd = &cobra.Command{
+	Use:   "cohorts",
+	Short: "Manage cohorts",
+	Long: `Manage cohorts from the command line.
+
+Run 'lazyhog' without arguments to browse cohorts interactively.`,
+}
+
+func init() {
+	rootCmd.AddCommand(cohortsCmd)
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cohortNameFlag  string
+	cohortQueryFlag string
+	cohortFileFlag  string
+)
+
+var cohortsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a static cohort from a HogQL query or a list of distinct IDs",
+	Long: `Create a static cohort containing the persons returned by a HogQL query,
+or listed in a file with one distinct ID per line ("-" reads stdin).
+
+Query results must have a person_id or distinct_id column. Distinct IDs are
+resolved to persons before they are added. The cohort can then be targeted
+by a feature flag. You are prompted for a name if --name is not given.
+
+HogQL returns at most 100 rows unless a query has a LIMIT, so queries without
+one get LIMIT 10000. If that many rows come back, the result is likely
+truncated and no cohort is created: narrow the query or give your own LIMIT.`,
+	Example: `  lazyhog cohorts create --name "Checkout errors" \
+    --query "SELECT DISTINCT distinct_id FROM events WHERE event = 'checkout_failed' AND timestamp > now() - INTERVAL 1 DAY LIMIT 10000"
+  lazyhog cohorts create --name "Beta testers" --file beta.txt`,
+	Args: cobra.NoArgs,
+	RunE: runCohortsCreate,
+}
+
+func init() {
+	cohortsCmd.AddCommand(cohortsCreateCmd)
+	cohortsCreateCmd.Flags().StringVar(&cohortNameFlag, "name", "", "Name of the new cohort")
+	cohortsCreateCmd.Flags().StringVar(&cohortQueryFlag, "query", "", "HogQL query returning a person_id or distinct_id column")
+	cohortsCreateCmd.Flags().StringVar(&cohortFileFlag, "file", "", "File with one distinct ID per line (\"-\" for stdin)")
+	cohortsCreateCmd.MarkFlagsOneRequired("query", "file")
+	cohortsCreateCmd.MarkFlagsMutuallyExclusive("query", "file")
+}
+
+func runCohortsCreate(cmd *cobra.Command, args []string) error {
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	var members utils.CohortMembers
+	if cohortQueryFlag != "" {
+		query, limited := utils.LimitCohortQuery(cohortQueryFlag)
+		result, err := c.ExecuteQuery(ctx, query)
+		if err != nil {
+			return err
+		}
+		if limited && len(result.Results) >= utils.CohortQueryLimit {
+			return fmt.Errorf("query returned %d rows, the most fetched without a LIMIT; narrow the query or add a LIMIT", len(result.Results))
+		}
+		members, err = utils.MembersFromQueryResult(result)
+		if err != nil {
+			return err
+		}
+	} else {
+		members.DistinctIDs, err = readDistinctIDs(cohortFileFlag)
+		if err != nil {
+			return err
+		}
+	}
+
+	if members.Len() == 0 {
+		return fmt.Errorf("no persons to add")
+	}
+
+	name := cohortNameFlag
+	if name == "" {
+		// Stdin may already be consumed by the ID list
+		if cohortFileFlag == "-" {
+			return fmt.Errorf("--name is required when reading IDs from stdin")
+		}
+		fmt.Printf("%d IDs found. Cohort name: ", members.Len())
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return fmt.Errorf("failed to read cohort name: %w", err)
+		}
+		name = strings.TrimSpace(line)
+		if name == "" {
+			return fmt.Errorf("cohort name is required")
+		}
+	}
+
+	cohort, added, err := utils.CreateStaticCohort(ctx, c, name, members)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created static cohort %q (#%d) with %d persons\n", cohort.Name, cohort.ID, added)
+	return nil
+}
+
+// readDistinctIDs reads one distinct ID per line from a file or stdin ("-"),
+// skipping blank lines
+func readDistinctIDs(filename string) ([]string, error) {
+	f := os.Stdin
+	if filename != "-" {
+		var err error
+		f, err = os.Open(filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", filename, err)
+		}
+		defer f.Close()
+	}
+
+	var ids []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if id := strings.TrimSpace(scanner.Text()); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read distinct IDs: %w", err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var flagsCmd = &cobra.Command{
+	Use:   "flags",
+	Short: "Inspect and manage feature flags",
+	Long: `Inspect and manage feature flags from the command line.
+
+Run 'lazyhog' without arguments to manage flags interactively.`,
+}
+
+func init() {
+	rootCmd.AddCommand(flagsCmd)
+}
+
+// findFlagByKey looks up a feature flag by its key
+func findFlagByKey(ctx context.Context, c client.PostHogClient, key string) (*client.FeatureFlag, error) {
+	flags, err := c.ListFlags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range flags {
+		if flags[i].Key == key {
+			return &flags[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("feature flag not found: %s", key)
+}
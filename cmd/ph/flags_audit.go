@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	auditDaysFlag       int
+	auditSortFlag       string
+	auditCandidatesFlag bool
+	auditCSVFlag        string
+)
+
+var flagsAuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Report stale and unused feature flags",
+	Long: `Report how every feature flag is used, to find flags that can be removed.
+
+For each flag the report shows its rollout state (off, partial or 100%),
+how often it was evaluated ($feature_flag_called events) in the last N days,
+when it was last evaluated and how long ago it was created.
+
+Flags older than the window that were never evaluated, are fully off or are
+rolled out to 100% are marked as removal candidates.`,
+	Example: `  lazyhog flags audit
+  lazyhog flags audit --days 90 --sort last-evaluated
+  lazyhog flags audit --candidates --csv stale-flags.csv`,
+	Args: cobra.NoArgs,
+	RunE: runFlagsAudit,
+}
+
+func init() {
+	flagsCmd.AddCommand(flagsAuditCmd)
+	flagsAuditCmd.Flags().IntVar(&auditDaysFlag, "days", 30, "Evaluation window in days")
+	flagsAuditCmd.Flags().StringVar(&auditSortFlag, "sort", "candidate", "Sort column: candidate, key, evaluations, last-evaluated, age, rollout")
+	flagsAuditCmd.Flags().BoolVar(&auditCandidatesFlag, "candidates", false, "Only show removal candidates")
+	flagsAuditCmd.Flags().StringVar(&auditCSVFlag, "csv", "", "Also export the report to a CSV file")
+}
+
+func runFlagsAudit(cmd *cobra.Command, args []string) error {
+	sortBy, err := utils.ParseFlagAuditSort(auditSortFlag)
+	if err != nil {
+		return err
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	flags, err := c.ListFlags(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list flags: %w", err)
+	}
+
+	usage, err := c.GetFlagUsage(ctx, auditDaysFlag)
+	if err != nil {
+		return fmt.Errorf("failed to fetch flag usage: %w", err)
+	}
+
+	now := time.Now()
+	rows := utils.BuildFlagAudit(flags, usage, auditDaysFlag, now)
+	utils.SortFlagAudit(rows, sortBy)
+
+	if auditCandidatesFlag {
+		candidates := rows[:0]
+		for _, row := range rows {
+			if row.Candidate {
+				candidates = append(candidates, row)
+			}
+		}
+		rows = candidates
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "\tKEY\tROLLOUT\tEVALS (%dd)\tLAST EVALUATED\tAGE\tREASONS\n", auditDaysFlag)
+	candidateCount := 0
+	for _, row := range rows {
+		marker := ""
+		if row.Candidate {
+			marker = "⚠"
+			candidateCount++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			marker,
+			row.Flag.Key,
+			row.Rollout,
+			row.Evaluations,
+			row.FormatLastEvaluated(now),
+			row.FormatAge(),
+			strings.Join(row.Reasons, ", "),
+		)
+	}
+	w.Flush()
+
+	fmt.Printf("\n%d flags, %d removal candidates\n", len(rows), candidateCount)
+
+	if auditCSVFlag != "" {
+		if err := utils.ExportFlagAuditCSV(rows, auditCSVFlag); err != nil {
+			return fmt.Errorf("failed to export report: %w", err)
+		}
+		fmt.Printf("Report exported to %s\n", auditCSVFlag)
+	}
+
+	return nil
+}
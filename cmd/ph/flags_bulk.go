@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	bulkMatchFlag       string
+	bulkYesFlag         bool
+	bulkConcurrencyFlag int
+)
+
+var flagsDisableCmd = &cobra.Command{
+	Use:   "disable --match <pattern>",
+	Short: "Disable every feature flag matching a pattern",
+	Long: `Disable every feature flag whose key matches a glob pattern.
+
+A preview of the affected flags and their current state is shown before
+anything changes. After applying, the result of every flag is reported and
+the whole batch can be rolled back with a single keypress.`,
+	Example: `  lazyhog flags disable --match 'checkout-*'
+  lazyhog flags disable --match 'checkout-*' --yes`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runFlagsBulk(false)
+	},
+}
+
+var flagsEnableCmd = &cobra.Command{
+	Use:   "enable --match <pattern>",
+	Short: "Enable every feature flag matching a pattern",
+	Long: `Enable every feature flag whose key matches a glob pattern.
+
+Works like 'lazyhog flags disable', with a preview and rollback.`,
+	Example: `  lazyhog flags enable --match 'checkout-*'`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runFlagsBulk(true)
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{flagsDisableCmd, flagsEnableCmd} {
+		flagsCmd.AddCommand(cmd)
+		cmd.Flags().StringVar(&bulkMatchFlag, "match", "", "Glob pattern matched against flag keys, e.g. 'checkout-*'")
+		cmd.Flags().BoolVarP(&bulkYesFlag, "yes", "y", false, "Apply without asking for confirmation")
+		cmd.Flags().IntVar(&bulkConcurrencyFlag, "concurrency", utils.DefaultBulkWorkers, "Maximum number of concurrent updates")
+		cmd.MarkFlagRequired("match")
+	}
+}
+
+func runFlagsBulk(active bool) error {
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	flags, err := c.ListFlags(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list flags: %w", err)
+	}
+
+	matched, err := utils.MatchFlags(flags, bulkMatchFlag)
+	if err != nil {
+		return err
+	}
+	if len(matched) == 0 {
+		fmt.Printf("No flags match %q\n", bulkMatchFlag)
+		return nil
+	}
+
+	toggles := utils.PlanFlagToggles(matched, active)
+	printBulkPreview(matched, active)
+	if len(toggles) == 0 {
+		fmt.Println("\nNothing to do: all matching flags are already in that state.")
+		return nil
+	}
+
+	in := bufio.NewReader(os.Stdin)
+	if !bulkYesFlag && !confirm(in, fmt.Sprintf("\nApply to %d flags? [y/N] ", len(toggles))) {
+		fmt.Println("Aborted.")
+		return nil
+	}
+
+	results := utils.ApplyFlagToggles(ctx, c, toggles, bulkConcurrencyFlag)
+	printBulkResults(results)
+
+	rollback := utils.RollbackToggles(results)
+	if len(rollback) == 0 || bulkYesFlag {
+		return bulkError(results)
+	}
+
+	if confirm(in, fmt.Sprintf("\nRoll back this batch (%d flags)? [y/N] ", len(rollback))) {
+		fmt.Println()
+		rollbackResults := utils.ApplyFlagToggles(ctx, c, rollback, bulkConcurrencyFlag)
+		printBulkResults(rollbackResults)
+		return bulkError(rollbackResults)
+	}
+
+	return bulkError(results)
+}
+
+// printBulkPreview prints the flags affected by a bulk operation and their current state
+func printBulkPreview(flags []client.FeatureFlag, active bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tCURRENT\tNEW")
+	for _, flag := range flags {
+		next := utils.FlagStateLabel(active)
+		if flag.Active == active {
+			next = "(unchanged)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", flag.Key, utils.FlagStateLabel(flag.Active), next)
+	}
+	w.Flush()
+}
+
+// printBulkResults prints the per-flag outcome of a bulk operation
+func printBulkResults(results []utils.FlagToggleResult) {
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Printf("✗ %s: %v\n", r.Flag.Key, r.Err)
+		} else {
+			fmt.Printf("✓ %s → %s\n", r.Flag.Key, utils.FlagStateLabel(r.Active))
+		}
+	}
+
+	failed := utils.CountFailures(results)
+	fmt.Printf("\n%d succeeded, %d failed\n", len(results)-failed, failed)
+}
+
+// bulkError returns an error if any flag in the batch failed to update
+func bulkError(results []utils.FlagToggleResult) error {
+	if failed := utils.CountFailures(results); failed > 0 {
+		return fmt.Errorf("%d of %d flags failed to update", failed, len(results))
+	}
+	return nil
+}
+
+// confirm asks a yes/no question on stdin, defaulting to no
+func confirm(in *bufio.Reader, prompt string) bool {
+	fmt.Print(prompt)
+	answer, err := in.ReadString('\n')
+	if err != nil {
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var flagsHistoryCmd = &cobra.Command{
+	Use:   "history <key>",
+	Short: "Show who changed a feature flag and what they changed",
+	Long: `Show the activity log of a feature flag as a timeline, newest first.
+
+Each entry lists the user, the time and a field-level before/after diff,
+including individual release conditions inside the flag's filters.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFlagsHistory,
+}
+
+func init() {
+	flagsCmd.AddCommand(flagsHistoryCmd)
+}
+
+func runFlagsHistory(cmd *cobra.Command, args []string) error {
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	flag, err := findFlagByKey(ctx, c, args[0])
+	if err != nil {
+		return err
+	}
+
+	entries, err := c.GetFlagActivity(ctx, flag.ID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch flag history: %w", err)
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("No changes recorded for %s\n", flag.Key)
+		return nil
+	}
+
+	for _, entry := range entries {
+		fmt.Printf("%s  %s  %s\n",
+			client.FormatEventTime(entry.CreatedAt.Local()),
+			entry.User.DisplayName(),
+			entry.Activity,
+		)
+		for _, change := range entry.Detail.Changes {
+			for _, diff := range utils.DiffJSON(change.Field, change.Before, change.After) {
+				fmt.Printf("    %s: %s → %s\n",
+					diff.Path,
+					utils.FormatJSONValue(diff.Before),
+					utils.FormatJSONValue(diff.After),
+				)
+			}
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
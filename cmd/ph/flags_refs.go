@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	refsRepoFlag   string
+	refsUnusedFlag bool
+)
+
+var flagsRefsCmd = &cobra.Command{
+	Use:   "refs",
+	Short: "Find references to feature flags in a local source tree",
+	Long: `Scan a local source tree for every feature flag key and report where each
+flag is used (file:line), followed by the flags with no references at all.
+
+The scan runs entirely offline against the flag list: .gitignore files are
+respected, .git directories, binary files and files over 2 MB are skipped,
+and keys only match as whole tokens.`,
+	Example: `  lazyhog flags refs --repo ./monorepo
+  lazyhog flags refs --repo . --unused`,
+	Args: cobra.NoArgs,
+	RunE: runFlagsRefs,
+}
+
+func init() {
+	flagsCmd.AddCommand(flagsRefsCmd)
+	flagsRefsCmd.Flags().StringVar(&refsRepoFlag, "repo", ".", "Local source tree to scan")
+	flagsRefsCmd.Flags().BoolVar(&refsUnusedFlag, "unused", false, "Only list flags with no references")
+}
+
+func runFlagsRefs(cmd *cobra.Command, args []string) error {
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	flags, err := c.ListFlags(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list flags: %w", err)
+	}
+
+	keys := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		if !flag.Deleted {
+			keys = append(keys, flag.Key)
+		}
+	}
+
+	refs, err := utils.ScanFlagReferences(refsRepoFlag, keys)
+	if err != nil {
+		return fmt.Errorf("failed to scan %s: %w", refsRepoFlag, err)
+	}
+
+	var unreferenced []string
+	for _, key := range keys {
+		keyRefs := refs[key]
+		if len(keyRefs) == 0 {
+			unreferenced = append(unreferenced, key)
+			continue
+		}
+		if refsUnusedFlag {
+			continue
+		}
+
+		fmt.Printf("%s (%d)\n", key, len(keyRefs))
+		for _, ref := range keyRefs {
+			fmt.Printf("  %s:%d  %s\n", ref.File, ref.Line, ref.Text)
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("Flags with no references (%d of %d):\n", len(unreferenced), len(keys))
+	for _, key := range unreferenced {
+		fmt.Printf("  %s\n", key)
+	}
+
+	return nil
+}
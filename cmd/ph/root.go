@@ -16,7 +16,10 @@ import (
 
 const version = "0.1.0"
 
-var debugFlag bool
+var (
+	debugFlag    bool
+	rootRepoFlag string
+)
 
 var rootCmd = &cobra.Command{
 	Use:   "lazyhog",
@@ -41,6 +44,7 @@ func init() {
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
 	rootCmd.SetVersionTemplate(fmt.Sprintf("lazyhog version %s\n", version))
 	rootCmd.PersistentFlags().BoolVar(&debugFlag, "debug", false, "Enable debug logging (shows full request/response details)")
+	rootCmd.Flags().StringVar(&rootRepoFlag, "repo", "", "Local source tree to scan for flag references (overrides repo_path in config)")
 }
 
 func runMillerColumns(cmd *cobra.Command, args []string) error {
@@ -52,6 +56,9 @@ func runMillerColumns(cmd *cobra.Command, args []string) error {
 
 	// Set debug mode from flag
 	cfg.Debug = debugFlag
+	if rootRepoFlag != "" {
+		cfg.RepoPath = rootRepoFlag
+	}
 
 	// Create client
 	c := client.New(cfg)
@@ -79,7 +86,7 @@ func runMillerColumns(cmd *cobra.Command, args []string) error {
 	defer c.Close()
 
 	// Create and run the Miller Columns TUI
-	m := miller.New(c)
+	m := miller.New(c, cfg)
 	p := tea.NewProgram(m, tea.WithAltScreen())
 
 	if _, err := p.Run(); err != nil {
@@ -88,3 +95,24 @@ func runMillerColumns(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// newClient loads the configuration and returns a client with the project
+// initialized, for non-interactive subcommands
+func newClient() (*client.Client, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w\nRun 'lazyhog login' to set up authentication", err)
+	}
+	cfg.Debug = debugFlag
+
+	c := client.New(cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := c.InitializeProject(ctx); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed to initialize project: %w", err)
+	}
+
+	return c, nil
+}
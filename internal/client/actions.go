@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ActionStep is one way of matching an action: an event plus optional
+// autocapture element and property criteria. Steps of an action are OR'ed.
+type ActionStep struct {
+	Event        *string                  `json:"event"`
+	Properties   []map[string]interface{} `json:"properties"`
+	Selector     *string                  `json:"selector"`
+	TagName      *string                  `json:"tag_name"`
+	Text         *string                  `json:"text"`
+	TextMatching *string                  `json:"text_matching"` // "exact", "contains" or "regex"
+	Href         *string                  `json:"href"`
+	HrefMatching *string                  `json:"href_matching"`
+	URL          *string                  `json:"url"`
+	URLMatching  *string                  `json:"url_matching"`
+}
+
+// Action represents a PostHog action: a named combination of events
+type Action struct {
+	ID          int          `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Tags        []string     `json:"tags"`
+	Steps       []ActionStep `json:"steps"`
+	CreatedAt   string       `json:"created_at"`
+	CreatedBy   *struct {
+		FirstName string `json:"first_name"`
+		Email     string `json:"email"`
+	} `json:"created_by"`
+	Deleted bool `json:"deleted"`
+}
+
+// ActionsResponse represents the API response for actions list
+type ActionsResponse struct {
+	Next     *string  `json:"next"`
+	Previous *string  `json:"previous"`
+	Results  []Action `json:"results"`
+}
+
+// ListActions fetches all actions, following pagination
+func (c *Client) ListActions(ctx context.Context) ([]Action, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("ListActions: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/actions/", c.getProjectPath())
+
+	var actions []Action
+	for path != "" {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("ListActions: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var actionsResp ActionsResponse
+		if err := json.Unmarshal(body, &actionsResp); err != nil {
+			return nil, fmt.Errorf("failed to parse actions response: %w", err)
+		}
+
+		for _, action := range actionsResp.Results {
+			if !action.Deleted {
+				actions = append(actions, action)
+			}
+		}
+		path = nextPagePath(actionsResp.Next)
+	}
+
+	return actions, nil
+}
+
+// CountEventsMatching counts the events in the given window matching a
+// HogQL condition
+func (c *Client) CountEventsMatching(ctx context.Context, condition string, window time.Duration) (int, error) {
+	query := fmt.Sprintf(`
+		SELECT count()
+		FROM events
+		WHERE (%s)
+			AND timestamp > now() - INTERVAL %d HOUR
+	`, condition, int(window.Hours()))
+
+	result, err := c.ExecuteQuery(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	if len(result.Results) == 0 || len(result.Results[0]) == 0 {
+		return 0, nil
+	}
+	// Counts are decoded from JSON as float64
+	count, _ := result.Results[0][0].(float64)
+	return int(count), nil
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ActivityUser represents the user who performed an activity
+type ActivityUser struct {
+	FirstName string `json:"first_name"`
+	Email     string `json:"email"`
+}
+
+// DisplayName returns the best human-readable name for the user
+func (u *ActivityUser) DisplayName() string {
+	if u == nil {
+		return "system"
+	}
+	if u.Email != "" {
+		return u.Email
+	}
+	if u.FirstName != "" {
+		return u.FirstName
+	}
+	return "unknown"
+}
+
+// ActivityChange represents a single field change within an activity
+type ActivityChange struct {
+	Type   string      `json:"type"`
+	Action string      `json:"action"`
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// ActivityDetail holds the details of an activity log entry
+type ActivityDetail struct {
+	Name    string           `json:"name"`
+	ShortID string           `json:"short_id"`
+	Changes []ActivityChange `json:"changes"`
+}
+
+// ActivityLogEntry represents a single entry in a PostHog activity log
+type ActivityLogEntry struct {
+	ID        string         `json:"id"`
+	User      *ActivityUser  `json:"user"`
+	Activity  string         `json:"activity"`
+	Scope     string         `json:"scope"`
+	ItemID    string         `json:"item_id"`
+	Detail    ActivityDetail `json:"detail"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// ActivityLogResponse represents the API response for an activity log
+type ActivityLogResponse struct {
+	Next     *string            `json:"next"`
+	Previous *string            `json:"previous"`
+	Results  []ActivityLogEntry `json:"results"`
+}
+
+// GetFlagActivity fetches the activity log (change history) of a feature
+// flag, following pagination
+func (c *Client) GetFlagActivity(ctx context.Context, flagID int) ([]ActivityLogEntry, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("GetFlagActivity: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/feature_flags/%d/activity/", c.getProjectPath(), flagID)
+
+	var entries []ActivityLogEntry
+	for path != "" {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("GetFlagActivity: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var activityResp ActivityLogResponse
+		if err := json.Unmarshal(body, &activityResp); err != nil {
+			return nil, fmt.Errorf("failed to parse activity response: %w", err)
+		}
+
+		entries = append(entries, activityResp.Results...)
+		path = nextPagePath(activityResp.Next)
+	}
+
+	return entries, nil
+}
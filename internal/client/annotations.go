@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Annotation scopes, from narrowest to widest
+const (
+	AnnotationScopeInsight      = "dashboard_item"
+	AnnotationScopeDashboard    = "dashboard"
+	AnnotationScopeProject      = "project"
+	AnnotationScopeOrganization = "organization"
+)
+
+// Annotation represents a PostHog annotation: a note pinned to a point in
+// time, such as a deploy, shown on charts
+type Annotation struct {
+	ID           int       `json:"id"`
+	Content      string    `json:"content"`
+	DateMarker   time.Time `json:"date_marker"`
+	Scope        string    `json:"scope"`
+	CreationType string    `json:"creation_type"` // "USR" for users, "GIT" for integrations
+	CreatedAt    string    `json:"created_at"`
+	CreatedBy    *struct {
+		FirstName string `json:"first_name"`
+		Email     string `json:"email"`
+	} `json:"created_by"`
+	Deleted bool `json:"deleted"`
+}
+
+// ScopeLabel returns a human-readable name of the annotation's scope
+func (a Annotation) ScopeLabel() string {
+	switch a.Scope {
+	case AnnotationScopeInsight:
+		return "Insight"
+	case AnnotationScopeDashboard:
+		return "Dashboard"
+	case AnnotationScopeProject:
+		return "Project"
+	case AnnotationScopeOrganization:
+		return "Organization"
+	default:
+		return a.Scope
+	}
+}
+
+// Creator returns the name or email of whoever created the annotation
+func (a Annotation) Creator() string {
+	switch {
+	case a.CreatedBy == nil:
+		if a.CreationType == "GIT" {
+			return "integration"
+		}
+		return "unknown"
+	case a.CreatedBy.FirstName != "":
+		return a.CreatedBy.FirstName
+	default:
+		return a.CreatedBy.Email
+	}
+}
+
+// AnnotationsResponse represents the API response for annotations list
+type AnnotationsResponse struct {
+	Next     *string      `json:"next"`
+	Previous *string      `json:"previous"`
+	Results  []Annotation `json:"results"`
+}
+
+// ListAnnotations fetches all annotations, following pagination
+func (c *Client) ListAnnotations(ctx context.Context) ([]Annotation, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("ListAnnotations: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/annotations/", c.getProjectPath())
+
+	var annotations []Annotation
+	for path != "" {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("ListAnnotations: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var annotationsResp AnnotationsResponse
+		if err := json.Unmarshal(body, &annotationsResp); err != nil {
+			return nil, fmt.Errorf("failed to parse annotations response: %w", err)
+		}
+
+		for _, annotation := range annotationsResp.Results {
+			if !annotation.Deleted {
+				annotations = append(annotations, annotation)
+			}
+		}
+		path = nextPagePath(annotationsResp.Next)
+	}
+
+	return annotations, nil
+}
+
+// CreateAnnotation creates a project-wide annotation at the given time
+func (c *Client) CreateAnnotation(ctx context.Context, content string, date time.Time) (*Annotation, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("CreateAnnotation: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/annotations/", c.getProjectPath())
+
+	data := map[string]interface{}{
+		"content":     content,
+		"date_marker": date.UTC().Format(time.RFC3339),
+		"scope":       AnnotationScopeProject,
+	}
+
+	resp, err := c.post(ctx, path, data)
+	if err != nil {
+		return nil, fmt.Errorf("CreateAnnotation: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var annotation Annotation
+	if err := json.Unmarshal(body, &annotation); err != nil {
+		return nil, fmt.Errorf("failed to parse annotation response: %w", err)
+	}
+
+	return &annotation, nil
+}
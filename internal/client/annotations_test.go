@@ -0,0 +1,33 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestAnnotationDecode(t *testing.T) {
+	body := `{
+		"id": 7,
+		"content": "Deployed v2.3",
+		"date_marker": "2024-03-05T14:30:00Z",
+		"scope": "project",
+		"creation_type": "USR",
+		"created_by": {"first_name": "", "email": "ops@example.com"}
+	}`
+
+	var annotation Annotation
+	if err := json.Unmarshal([]byte(body), &annotation); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if want := time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC); !annotation.DateMarker.Equal(want) {
+		t.Errorf("DateMarker = %v, want %v", annotation.DateMarker, want)
+	}
+	if got := annotation.ScopeLabel(); got != "Project" {
+		t.Errorf("ScopeLabel() = %q, want %q", got, "Project")
+	}
+	if got := annotation.Creator(); got != "ops@example.com" {
+		t.Errorf("Creator() = %q, want %q", got, "ops@example.com")
+	}
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PropertyValueCount is how often a property value was seen
+type PropertyValueCount struct {
+	Value string // empty if the property was not set
+	Count float64
+}
+
+// PropertyBreakdown is the top values of a property for an event
+type PropertyBreakdown struct {
+	Values []PropertyValueCount
+	Total  float64 // events in the window, including those with other values
+}
+
+// GetPropertyBreakdown counts the top values of an event property over the
+// given window, most frequent first
+func (c *Client) GetPropertyBreakdown(ctx context.Context, event, property string, window time.Duration, limit int) (*PropertyBreakdown, error) {
+	query := fmt.Sprintf(`
+		SELECT value, count, sum(count) OVER () AS total
+		FROM (
+			SELECT toString(%s) AS value, count() AS count
+			FROM events
+			WHERE event = %s
+				AND timestamp > now() - INTERVAL %d HOUR
+			GROUP BY value
+		)
+		ORDER BY count DESC
+		LIMIT %d
+	`, HogQLProperty(property), QuoteHogQLString(event), int(window.Hours()), limit)
+
+	result, err := c.ExecuteQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query property breakdown: %w", err)
+	}
+
+	breakdown := &PropertyBreakdown{}
+	for _, row := range result.Results {
+		if value, total, ok := parsePropertyValueCountFromRow(row); ok {
+			breakdown.Values = append(breakdown.Values, value)
+			breakdown.Total = total
+		}
+	}
+
+	return breakdown, nil
+}
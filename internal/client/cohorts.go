@@ -0,0 +1,230 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Cohort represents a PostHog cohort (a saved group of persons)
+type Cohort struct {
+	ID              int                      `json:"id"`
+	Name            string                   `json:"name"`
+	Description     string                   `json:"description"`
+	Count           *int                     `json:"count"`
+	IsStatic        bool                     `json:"is_static"`
+	IsCalculating   bool                     `json:"is_calculating"`
+	LastCalculation *time.Time               `json:"last_calculation"`
+	Filters         map[string]interface{}   `json:"filters"`
+	Groups          []map[string]interface{} `json:"groups"` // legacy filter definition
+	CreatedAt       string                   `json:"created_at"`
+	Deleted         bool                     `json:"deleted"`
+}
+
+// Kind describes whether the cohort is a static list or recalculated from filters
+func (c Cohort) Kind() string {
+	if c.IsStatic {
+		return "static"
+	}
+	return "dynamic"
+}
+
+// CohortsResponse represents the API response for cohorts list
+type CohortsResponse struct {
+	Next     *string  `json:"next"`
+	Previous *string  `json:"previous"`
+	Results  []Cohort `json:"results"`
+}
+
+// ListCohorts fetches all cohorts, following pagination
+func (c *Client) ListCohorts(ctx context.Context) ([]Cohort, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("ListCohorts: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/cohorts/", c.getProjectPath())
+
+	var cohorts []Cohort
+	for path != "" {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("ListCohorts: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var cohortsResp CohortsResponse
+		if err := json.Unmarshal(body, &cohortsResp); err != nil {
+			return nil, fmt.Errorf("failed to parse cohorts response: %w", err)
+		}
+
+		for _, cohort := range cohortsResp.Results {
+			if !cohort.Deleted {
+				cohorts = append(cohorts, cohort)
+			}
+		}
+		path = nextPagePath(cohortsResp.Next)
+	}
+
+	return cohorts, nil
+}
+
+// ListCohortPersons fetches one page of a cohort's members. The returned bool
+// reports whether more members follow this page.
+func (c *Client) ListCohortPersons(ctx context.Context, cohortID, limit, offset int) ([]Person, bool, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, false, fmt.Errorf("ListCohortPersons: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/cohorts/%d/persons/?limit=%d&offset=%d", c.getProjectPath(), cohortID, limit, offset)
+
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, false, fmt.Errorf("ListCohortPersons: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var personsResp PersonsResponse
+	if err := json.Unmarshal(body, &personsResp); err != nil {
+		return nil, false, fmt.Errorf("failed to parse cohort persons response: %w", err)
+	}
+
+	return personsResp.Results, personsResp.Next != nil, nil
+}
+
+// GetPersonCohorts fetches the cohorts a person (by UUID) belongs to
+func (c *Client) GetPersonCohorts(ctx context.Context, personUUID string) ([]Cohort, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("GetPersonCohorts: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/persons/cohorts/?person_id=%s", c.getProjectPath(), url.QueryEscape(personUUID))
+
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("GetPersonCohorts: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var cohortsResp CohortsResponse
+	if err := json.Unmarshal(body, &cohortsResp); err != nil {
+		return nil, fmt.Errorf("failed to parse person cohorts response: %w", err)
+	}
+
+	return cohortsResp.Results, nil
+}
+
+// CreateStaticCohort creates an empty static cohort
+func (c *Client) CreateStaticCohort(ctx context.Context, name string) (*Cohort, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("CreateStaticCohort: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/cohorts/", c.getProjectPath())
+
+	data := map[string]interface{}{
+		"name":      name,
+		"is_static": true,
+	}
+
+	resp, err := c.post(ctx, path, data)
+	if err != nil {
+		return nil, fmt.Errorf("CreateStaticCohort: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var cohort Cohort
+	if err := json.Unmarshal(body, &cohort); err != nil {
+		return nil, fmt.Errorf("failed to parse cohort response: %w", err)
+	}
+
+	return &cohort, nil
+}
+
+// AddPersonsToStaticCohort adds persons (by UUID) to a static cohort
+func (c *Client) AddPersonsToStaticCohort(ctx context.Context, cohortID int, personUUIDs []string) error {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return fmt.Errorf("AddPersonsToStaticCohort: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/cohorts/%d/add_persons_to_static_cohort/", c.getProjectPath(), cohortID)
+
+	data := map[string]interface{}{
+		"person_ids": personUUIDs,
+	}
+
+	resp, err := c.patch(ctx, path, data)
+	if err != nil {
+		return fmt.Errorf("AddPersonsToStaticCohort: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// resolvePersonUUIDsQuery builds the query for the persons of distinct IDs,
+// limited to one row per ID as HogQL returns only 100 rows without a LIMIT
+func resolvePersonUUIDsQuery(distinctIDs []string) string {
+	quoted := make([]string, len(distinctIDs))
+	for i, id := range distinctIDs {
+		quoted[i] = QuoteHogQLString(id)
+	}
+
+	return fmt.Sprintf(`
+		SELECT DISTINCT person_id
+		FROM person_distinct_ids
+		WHERE distinct_id IN (%s)
+		LIMIT %d
+	`, strings.Join(quoted, ", "), len(distinctIDs))
+}
+
+// ResolvePersonUUIDs maps distinct IDs to the UUIDs of their persons.
+// Distinct IDs without a person are skipped.
+func (c *Client) ResolvePersonUUIDs(ctx context.Context, distinctIDs []string) ([]string, error) {
+	if len(distinctIDs) == 0 {
+		return nil, nil
+	}
+
+	result, err := c.ExecuteQuery(ctx, resolvePersonUUIDsQuery(distinctIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve persons: %w", err)
+	}
+
+	uuids := make([]string, 0, len(result.Results))
+	for _, row := range result.Results {
+		if len(row) > 0 {
+			if uuid, ok := row[0].(string); ok && uuid != "" {
+				uuids = append(uuids, uuid)
+			}
+		}
+	}
+
+	return uuids, nil
+}
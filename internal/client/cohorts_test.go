@@ -0,0 +1,23 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestResolvePersonUUIDsQuery(t *testing.T) {
+	ids := make([]string, 250)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("user-%d", i)
+	}
+
+	query := resolvePersonUUIDsQuery(ids)
+
+	if !strings.Contains(query, "LIMIT 250") {
+		t.Errorf("query should be limited to one row per distinct ID, got:\n%s", query)
+	}
+	if !strings.Contains(query, "'user-249'") {
+		t.Errorf("query should include every distinct ID, got:\n%s", query)
+	}
+}
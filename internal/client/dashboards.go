@@ -0,0 +1,274 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// InsightKind is the type of analysis an insight performs
+type InsightKind string
+
+const (
+	InsightTrends  InsightKind = "trends"
+	InsightFunnels InsightKind = "funnels"
+	InsightOther   InsightKind = "other"
+)
+
+// Insight represents a saved PostHog insight with its cached result
+type Insight struct {
+	ID          int                    `json:"id"`
+	ShortID     string                 `json:"short_id"`
+	Name        string                 `json:"name"`
+	DerivedName string                 `json:"derived_name"`
+	Description string                 `json:"description"`
+	Filters     map[string]interface{} `json:"filters"` // legacy insight definition
+	Query       map[string]interface{} `json:"query"`
+	Result      json.RawMessage        `json:"result"`
+	LastRefresh *string                `json:"last_refresh"`
+}
+
+// DisplayName returns the insight's name, falling back to its derived name
+func (i Insight) DisplayName() string {
+	if i.Name != "" {
+		return i.Name
+	}
+	if i.DerivedName != "" {
+		return i.DerivedName
+	}
+	return "(untitled insight)"
+}
+
+// source returns the insight query node (the source of an InsightVizNode,
+// or the query itself)
+func (i Insight) source() map[string]interface{} {
+	if source, ok := i.Query["source"].(map[string]interface{}); ok {
+		return source
+	}
+	return i.Query
+}
+
+// Kind determines the insight type from its query or legacy filters
+func (i Insight) Kind() InsightKind {
+	kind, _ := i.source()["kind"].(string)
+	if kind == "" {
+		kind, _ = i.Filters["insight"].(string)
+	}
+
+	switch strings.ToLower(strings.TrimSuffix(kind, "Query")) {
+	case "trends":
+		return InsightTrends
+	case "funnels":
+		return InsightFunnels
+	default:
+		return InsightOther
+	}
+}
+
+// Display returns the chart type the insight is shown as in PostHog,
+// e.g. "ActionsLineGraph", "ActionsBar" or "BoldNumber"
+func (i Insight) Display() string {
+	if filter, ok := i.source()["trendsFilter"].(map[string]interface{}); ok {
+		if display, ok := filter["display"].(string); ok {
+			return display
+		}
+	}
+	display, _ := i.Filters["display"].(string)
+	return display
+}
+
+// TrendSeries is one series of a trends insight result
+type TrendSeries struct {
+	Label           string      `json:"label"`
+	Count           float64     `json:"count"`
+	AggregatedValue *float64    `json:"aggregated_value"`
+	Data            []float64   `json:"data"`
+	Labels          []string    `json:"labels"`
+	Days            []string    `json:"days"`
+	BreakdownValue  interface{} `json:"breakdown_value"`
+}
+
+// Total returns the series total: its aggregated value if the insight
+// aggregates, otherwise its count
+func (s TrendSeries) Total() float64 {
+	if s.AggregatedValue != nil {
+		return *s.AggregatedValue
+	}
+	return s.Count
+}
+
+// trendDayLayouts are the formats of the bucket starts in TrendSeries.Days
+var trendDayLayouts = []string{"2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"}
+
+// Buckets returns the start of each of the series' buckets, which are in the
+// project's timezone loc, or nil if they can't be parsed
+func (s TrendSeries) Buckets(loc *time.Location) []time.Time {
+	buckets := make([]time.Time, 0, len(s.Days))
+	for _, day := range s.Days {
+		parsed := false
+		for _, layout := range trendDayLayouts {
+			if t, err := time.ParseInLocation(layout, day, loc); err == nil {
+				buckets = append(buckets, t)
+				parsed = true
+				break
+			}
+		}
+		if !parsed {
+			return nil
+		}
+	}
+	return buckets
+}
+
+// FunnelStep is one step of a funnel insight result
+type FunnelStep struct {
+	Name                  string   `json:"name"`
+	CustomName            *string  `json:"custom_name"`
+	Order                 int      `json:"order"`
+	Count                 float64  `json:"count"`
+	AverageConversionTime *float64 `json:"average_conversion_time"`
+	MedianConversionTime  *float64 `json:"median_conversion_time"`
+}
+
+// DisplayName returns the step's custom name, falling back to its event name
+func (s FunnelStep) DisplayName() string {
+	if s.CustomName != nil && *s.CustomName != "" {
+		return *s.CustomName
+	}
+	return s.Name
+}
+
+// TrendSeries decodes the result of a trends insight
+func (i Insight) TrendSeries() ([]TrendSeries, error) {
+	var series []TrendSeries
+	if err := json.Unmarshal(i.Result, &series); err != nil {
+		return nil, fmt.Errorf("failed to parse trends result: %w", err)
+	}
+	return series, nil
+}
+
+// FunnelSteps decodes the result of a funnel insight. For funnels with a
+// breakdown, the steps of the first breakdown value are returned.
+func (i Insight) FunnelSteps() ([]FunnelStep, error) {
+	return parseFunnelSteps(i.Result)
+}
+
+// parseFunnelSteps decodes a funnel result, taking the first breakdown value's
+// steps if the result is broken down
+func parseFunnelSteps(result json.RawMessage) ([]FunnelStep, error) {
+	var steps []FunnelStep
+	if err := json.Unmarshal(result, &steps); err == nil {
+		return steps, nil
+	}
+
+	var breakdown [][]FunnelStep
+	if err := json.Unmarshal(result, &breakdown); err != nil {
+		return nil, fmt.Errorf("failed to parse funnel result: %w", err)
+	}
+	if len(breakdown) == 0 {
+		return nil, nil
+	}
+	return breakdown[0], nil
+}
+
+// HasResult reports whether the insight has a cached result
+func (i Insight) HasResult() bool {
+	return len(i.Result) > 0 && string(i.Result) != "null"
+}
+
+// DashboardTile is a tile on a dashboard: an insight or a text card
+type DashboardTile struct {
+	ID      int      `json:"id"`
+	Insight *Insight `json:"insight"`
+	Text    *struct {
+		Body string `json:"body"`
+	} `json:"text"`
+	Deleted bool `json:"deleted"`
+}
+
+// Dashboard represents a PostHog dashboard. Tiles are only populated when
+// the dashboard is fetched individually.
+type Dashboard struct {
+	ID          int             `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Pinned      bool            `json:"pinned"`
+	Tags        []string        `json:"tags"`
+	CreatedAt   string          `json:"created_at"`
+	Deleted     bool            `json:"deleted"`
+	Tiles       []DashboardTile `json:"tiles"`
+}
+
+// DashboardsResponse represents the API response for dashboards list
+type DashboardsResponse struct {
+	Next     *string     `json:"next"`
+	Previous *string     `json:"previous"`
+	Results  []Dashboard `json:"results"`
+}
+
+// ListDashboards fetches all dashboards (without tiles), following pagination
+func (c *Client) ListDashboards(ctx context.Context) ([]Dashboard, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("ListDashboards: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/dashboards/", c.getProjectPath())
+
+	var dashboards []Dashboard
+	for path != "" {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("ListDashboards: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var dashboardsResp DashboardsResponse
+		if err := json.Unmarshal(body, &dashboardsResp); err != nil {
+			return nil, fmt.Errorf("failed to parse dashboards response: %w", err)
+		}
+
+		for _, dashboard := range dashboardsResp.Results {
+			if !dashboard.Deleted {
+				dashboards = append(dashboards, dashboard)
+			}
+		}
+		path = nextPagePath(dashboardsResp.Next)
+	}
+
+	return dashboards, nil
+}
+
+// GetDashboard fetches a dashboard with its tiles and their cached insight results
+func (c *Client) GetDashboard(ctx context.Context, dashboardID int) (*Dashboard, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("GetDashboard: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/dashboards/%d/", c.getProjectPath(), dashboardID)
+
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("GetDashboard: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var dashboard Dashboard
+	if err := json.Unmarshal(body, &dashboard); err != nil {
+		return nil, fmt.Errorf("failed to parse dashboard response: %w", err)
+	}
+
+	return &dashboard, nil
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestInsight_Kind(t *testing.T) {
+	tests := []struct {
+		name    string
+		insight Insight
+		want    InsightKind
+	}{
+		{
+			name:    "trends query",
+			insight: Insight{Query: map[string]interface{}{"kind": "InsightVizNode", "source": map[string]interface{}{"kind": "TrendsQuery"}}},
+			want:    InsightTrends,
+		},
+		{
+			name:    "legacy funnel filters",
+			insight: Insight{Filters: map[string]interface{}{"insight": "FUNNELS"}},
+			want:    InsightFunnels,
+		},
+		{
+			name:    "hogql table",
+			insight: Insight{Query: map[string]interface{}{"kind": "DataTableNode", "source": map[string]interface{}{"kind": "HogQLQuery"}}},
+			want:    InsightOther,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.insight.Kind(); got != tt.want {
+				t.Errorf("Kind() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInsight_FunnelSteps(t *testing.T) {
+	step := `{"name": "signup", "order": 0, "count": 100, "median_conversion_time": null}`
+
+	flat := Insight{Result: json.RawMessage("[" + step + "]")}
+	steps, err := flat.FunnelSteps()
+	if err != nil || len(steps) != 1 || steps[0].Count != 100 {
+		t.Errorf("FunnelSteps() = %v, %v; want one step with count 100", steps, err)
+	}
+
+	breakdown := Insight{Result: json.RawMessage("[[" + step + "], []]")}
+	steps, err = breakdown.FunnelSteps()
+	if err != nil || len(steps) != 1 || steps[0].DisplayName() != "signup" {
+		t.Errorf("FunnelSteps() with breakdown = %v, %v; want the first breakdown's steps", steps, err)
+	}
+}
+
+func TestTrendSeries_Buckets(t *testing.T) {
+	hourly := TrendSeries{Days: []string{"2024-01-02 13:00:00", "2024-01-02 14:00:00"}}
+	buckets := hourly.Buckets(time.UTC)
+	if len(buckets) != 2 || !buckets[1].Equal(time.Date(2024, 1, 2, 14, 0, 0, 0, time.UTC)) {
+		t.Errorf("Buckets() = %v, want 13:00 and 14:00 on Jan 2", buckets)
+	}
+
+	daily := TrendSeries{Days: []string{"2024-01-01", "2024-01-02"}}
+	if buckets := daily.Buckets(time.UTC); len(buckets) != 2 || buckets[1].Sub(buckets[0]) != 24*time.Hour {
+		t.Errorf("Buckets() = %v, want two days", buckets)
+	}
+
+	if buckets := (TrendSeries{Days: []string{"2024-01-01", "last week"}}).Buckets(time.UTC); buckets != nil {
+		t.Errorf("Buckets() = %v, want nil for an unparseable day", buckets)
+	}
+}
+
+func TestTrendSeries_BucketsInProjectTimezone(t *testing.T) {
+	// Days start at midnight in the project's timezone, not in UTC
+	project := Project{Timezone: "America/New_York"}
+	series := TrendSeries{Days: []string{"2024-01-01", "2024-01-02"}}
+
+	buckets := series.Buckets(project.Location())
+	if want := time.Date(2024, 1, 2, 5, 0, 0, 0, time.UTC); len(buckets) != 2 || !buckets[1].Equal(want) {
+		t.Errorf("Buckets() = %v, want the second day to start at %v", buckets, want)
+	}
+
+	if loc := (Project{Timezone: "Not/AZone"}).Location(); loc != time.UTC {
+		t.Errorf("Location() = %v, want UTC for an unknown timezone", loc)
+	}
+}
@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// DefinitionOwner is the member responsible for an event definition
+type DefinitionOwner struct {
+	ID        int    `json:"id"`
+	FirstName string `json:"first_name"`
+	Email     string `json:"email"`
+}
+
+// EventDefinition represents an event name known to the project, with the
+// metadata curated for it
+type EventDefinition struct {
+	ID              string           `json:"id"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	Tags            []string         `json:"tags"`
+	Volume30Day     *int             `json:"volume_30_day"`
+	QueryUsage30Day *int             `json:"query_usage_30_day"`
+	LastSeenAt      *time.Time       `json:"last_seen_at"`
+	CreatedAt       *time.Time       `json:"created_at"`
+	Verified        bool             `json:"verified"`
+	Hidden          bool             `json:"hidden"`
+	Owner           *DefinitionOwner `json:"owner"`
+}
+
+// EventDefinitionsResponse represents the API response for event definitions list
+type EventDefinitionsResponse struct {
+	Next     *string           `json:"next"`
+	Previous *string           `json:"previous"`
+	Results  []EventDefinition `json:"results"`
+}
+
+// PropertyDefinition represents a property known to the project
+type PropertyDefinition struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Tags         []string `json:"tags"`
+	PropertyType *string  `json:"property_type"`
+	IsNumerical  bool     `json:"is_numerical"`
+	Verified     bool     `json:"verified"`
+	Hidden       bool     `json:"hidden"`
+}
+
+// Type returns the property's type (e.g. "String", "Numeric", "DateTime"),
+// or "unknown" if PostHog hasn't inferred one
+func (p PropertyDefinition) Type() string {
+	if p.PropertyType != nil && *p.PropertyType != "" {
+		return *p.PropertyType
+	}
+	return "unknown"
+}
+
+// PropertyDefinitionsResponse represents the API response for property definitions list
+type PropertyDefinitionsResponse struct {
+	Next     *string              `json:"next"`
+	Previous *string              `json:"previous"`
+	Results  []PropertyDefinition `json:"results"`
+}
+
+// ListEventDefinitions fetches all event definitions, following pagination
+func (c *Client) ListEventDefinitions(ctx context.Context) ([]EventDefinition, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("ListEventDefinitions: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/event_definitions/?limit=500", c.getProjectPath())
+
+	var definitions []EventDefinition
+	for path != "" {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("ListEventDefinitions: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var definitionsResp EventDefinitionsResponse
+		if err := json.Unmarshal(body, &definitionsResp); err != nil {
+			return nil, fmt.Errorf("failed to parse event definitions response: %w", err)
+		}
+
+		definitions = append(definitions, definitionsResp.Results...)
+		path = nextPagePath(definitionsResp.Next)
+	}
+
+	return definitions, nil
+}
+
+// ListEventProperties fetches the definitions of the properties seen on an
+// event, following pagination
+func (c *Client) ListEventProperties(ctx context.Context, event string) ([]PropertyDefinition, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("ListEventProperties: %w", err)
+	}
+
+	eventNames, err := json.Marshal([]string{event})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event names: %w", err)
+	}
+
+	params := url.Values{}
+	params.Set("type", "event")
+	params.Set("event_names", string(eventNames))
+	params.Set("filter_by_event_names", "true")
+	params.Set("limit", "500")
+	path := fmt.Sprintf("%s/property_definitions/?%s", c.getProjectPath(), params.Encode())
+
+	var definitions []PropertyDefinition
+	for path != "" {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("ListEventProperties: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var definitionsResp PropertyDefinitionsResponse
+		if err := json.Unmarshal(body, &definitionsResp); err != nil {
+			return nil, fmt.Errorf("failed to parse property definitions response: %w", err)
+		}
+
+		definitions = append(definitions, definitionsResp.Results...)
+		path = nextPagePath(definitionsResp.Next)
+	}
+
+	return definitions, nil
+}
+
+// DefinitionUpdate is a partial update of an event or property definition.
+// Nil fields are left unchanged. Owner only applies to event definitions.
+type DefinitionUpdate struct {
+	Description *string   `json:"description,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+	Owner       *int      `json:"owner,omitempty"`
+	Verified    *bool     `json:"verified,omitempty"`
+	Hidden      *bool     `json:"hidden,omitempty"`
+}
+
+// UpdateEventDefinition updates an event definition's metadata
+func (c *Client) UpdateEventDefinition(ctx context.Context, id string, update DefinitionUpdate) (*EventDefinition, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("UpdateEventDefinition: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/event_definitions/%s/", c.getProjectPath(), url.PathEscape(id))
+
+	resp, err := c.patch(ctx, path, update)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateEventDefinition: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var definition EventDefinition
+	if err := json.Unmarshal(body, &definition); err != nil {
+		return nil, fmt.Errorf("failed to parse event definition response: %w", err)
+	}
+
+	return &definition, nil
+}
+
+// UpdatePropertyDefinition updates a property definition's metadata
+func (c *Client) UpdatePropertyDefinition(ctx context.Context, id string, update DefinitionUpdate) (*PropertyDefinition, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("UpdatePropertyDefinition: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/property_definitions/%s/", c.getProjectPath(), url.PathEscape(id))
+
+	update.Owner = nil // property definitions have no owner
+	resp, err := c.patch(ctx, path, update)
+	if err != nil {
+		return nil, fmt.Errorf("UpdatePropertyDefinition: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var definition PropertyDefinition
+	if err := json.Unmarshal(body, &definition); err != nil {
+		return nil, fmt.Errorf("failed to parse property definition response: %w", err)
+	}
+
+	return &definition, nil
+}
+
+// ListOrganizationMembers fetches the members of the current organization,
+// who can own definitions
+func (c *Client) ListOrganizationMembers(ctx context.Context) ([]DefinitionOwner, error) {
+	path := "/api/organizations/@current/members/"
+
+	var members []DefinitionOwner
+	for path != "" {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("ListOrganizationMembers: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var membersResp struct {
+			Next    *string `json:"next"`
+			Results []struct {
+				User DefinitionOwner `json:"user"`
+			} `json:"results"`
+		}
+		if err := json.Unmarshal(body, &membersResp); err != nil {
+			return nil, fmt.Errorf("failed to parse members response: %w", err)
+		}
+
+		for _, member := range membersResp.Results {
+			members = append(members, member.User)
+		}
+		path = nextPagePath(membersResp.Next)
+	}
+
+	return members, nil
+}
@@ -0,0 +1,21 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPropertyDefinition_Type(t *testing.T) {
+	var properties []PropertyDefinition
+	body := `[{"name": "plan", "property_type": "String"}, {"name": "$browser", "property_type": null}]`
+	if err := json.Unmarshal([]byte(body), &properties); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if got := properties[0].Type(); got != "String" {
+		t.Errorf("Type() = %q, want %q", got, "String")
+	}
+	if got := properties[1].Type(); got != "unknown" {
+		t.Errorf("Type() with null property_type = %q, want %q", got, "unknown")
+	}
+}
@@ -28,6 +28,17 @@ type EventsResponse struct {
 
 // ListRecentEvents fetches recent events using the Query API with HogQL
 func (c *Client) ListRecentEvents(ctx context.Context, limit int) ([]Event, error) {
+	return c.listEvents(ctx, "", limit)
+}
+
+// ListRecentEventsMatching fetches recent events matching a HogQL
+// condition, e.g. "event = '$pageview'"
+func (c *Client) ListRecentEventsMatching(ctx context.Context, condition string, limit int) ([]Event, error) {
+	return c.listEvents(ctx, "WHERE ("+condition+")", limit)
+}
+
+// listEvents fetches the most recent events matching a HogQL WHERE clause
+func (c *Client) listEvents(ctx context.Context, where string, limit int) ([]Event, error) {
 	if limit <= 0 {
 		limit = 50
 	}
@@ -42,9 +53,10 @@ func (c *Client) ListRecentEvents(ctx context.Context, limit int) ([]Event, erro
 			properties,
 			person_id
 		FROM events
+		%s
 		ORDER BY timestamp DESC
 		LIMIT %d
-	`, limit)
+	`, where, limit)
 
 	result, err := c.ExecuteQuery(ctx, query)
 	if err != nil {
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// exceptionEvent is the event captured for frontend and backend exceptions
+const exceptionEvent = "$exception"
+
+// HogQL expressions reading an exception's type and message, from the legacy
+// properties or the first entry of $exception_list
+var (
+	exceptionTypeExpr = "coalesce(nullIf(properties.$exception_type, ''), " +
+		"nullIf(JSONExtractString(properties.$exception_list, 1, 'type'), ''), 'Error')"
+	exceptionMessageExpr = "coalesce(nullIf(properties.$exception_message, ''), " +
+		"JSONExtractString(properties.$exception_list, 1, 'value'), '')"
+	// exceptionGroupExpr groups exceptions by fingerprint, or by type and
+	// message if they have none
+	exceptionGroupExpr = fmt.Sprintf("coalesce(nullIf(toString(properties.$exception_fingerprint), ''), concat(%s, ': ', %s))",
+		exceptionTypeExpr, exceptionMessageExpr)
+)
+
+// ExceptionGroup is a group of $exception events sharing a fingerprint, or a
+// type and message
+type ExceptionGroup struct {
+	Key         string // fingerprint, or "Type: message"
+	Type        string
+	Message     string
+	Occurrences int
+	Users       int
+	FirstSeen   time.Time
+	LastSeen    time.Time
+}
+
+// ExceptionTrendPoint is the number of occurrences of an exception group and
+// of affected users on a day
+type ExceptionTrendPoint struct {
+	Day         time.Time
+	Occurrences int
+	Users       int
+}
+
+// ExceptionGroupCondition returns a HogQL condition matching the $exception
+// events of a group
+func ExceptionGroupCondition(key string) string {
+	return fmt.Sprintf("event = %s AND %s = %s", QuoteHogQLString(exceptionEvent), exceptionGroupExpr, QuoteHogQLString(key))
+}
+
+// ListExceptionGroups groups the $exception events of the last days,
+// most frequent first
+func (c *Client) ListExceptionGroups(ctx context.Context, days, limit int) ([]ExceptionGroup, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			%s AS issue,
+			any(%s) AS type,
+			any(%s) AS message,
+			count() AS occurrences,
+			count(DISTINCT person_id) AS users,
+			min(timestamp) AS first_seen,
+			max(timestamp) AS last_seen
+		FROM events
+		WHERE event = %s
+			AND timestamp > now() - INTERVAL %d DAY
+		GROUP BY issue
+		ORDER BY occurrences DESC
+		LIMIT %d
+	`, exceptionGroupExpr, exceptionTypeExpr, exceptionMessageExpr, QuoteHogQLString(exceptionEvent), days, limit)
+
+	result, err := c.ExecuteQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query exceptions: %w", err)
+	}
+
+	groups := make([]ExceptionGroup, 0, len(result.Results))
+	for _, row := range result.Results {
+		if group, ok := parseExceptionGroupFromRow(row); ok {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups, nil
+}
+
+// GetExceptionTrend counts an exception group's occurrences and affected
+// users per day over the last days. Days without occurrences are not returned.
+func (c *Client) GetExceptionTrend(ctx context.Context, key string, days int) ([]ExceptionTrendPoint, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			toStartOfDay(timestamp) AS day,
+			count() AS occurrences,
+			count(DISTINCT person_id) AS users
+		FROM events
+		WHERE %s
+			AND timestamp > now() - INTERVAL %d DAY
+		GROUP BY day
+		ORDER BY day
+	`, ExceptionGroupCondition(key), days)
+
+	result, err := c.ExecuteQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query exception trend: %w", err)
+	}
+
+	points := make([]ExceptionTrendPoint, 0, len(result.Results))
+	for _, row := range result.Results {
+		if point, ok := parseExceptionTrendPointFromRow(row); ok {
+			points = append(points, point)
+		}
+	}
+
+	return points, nil
+}
+
+// GetLatestException fetches the most recent $exception event of a group,
+// or nil if there is none
+func (c *Client) GetLatestException(ctx context.Context, key string) (*Event, error) {
+	events, err := c.listEvents(ctx, "WHERE "+ExceptionGroupCondition(key), 1)
+	if err != nil {
+		return nil, fmt.Errorf("GetLatestException: %w", err)
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+	return &events[0], nil
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ExperimentStatus is the lifecycle stage of an experiment
+type ExperimentStatus string
+
+const (
+	ExperimentDraft    ExperimentStatus = "draft"
+	ExperimentRunning  ExperimentStatus = "running"
+	ExperimentComplete ExperimentStatus = "complete"
+)
+
+// ExperimentVariant is one arm of an experiment
+type ExperimentVariant struct {
+	Key               string  `json:"key"`
+	Name              string  `json:"name,omitempty"`
+	RolloutPercentage float64 `json:"rollout_percentage"`
+}
+
+// ExperimentParameters holds the configuration of an experiment
+type ExperimentParameters struct {
+	FeatureFlagVariants     []ExperimentVariant `json:"feature_flag_variants"`
+	MinimumDetectableEffect float64             `json:"minimum_detectable_effect,omitempty"`
+	RecommendedSampleSize   float64             `json:"recommended_sample_size,omitempty"`
+}
+
+// Experiment represents a PostHog experiment (A/B test built on a feature flag)
+type Experiment struct {
+	ID             int                      `json:"id"`
+	Name           string                   `json:"name"`
+	Description    string                   `json:"description"`
+	FeatureFlagKey string                   `json:"feature_flag_key"`
+	StartDate      *time.Time               `json:"start_date"`
+	EndDate        *time.Time               `json:"end_date"`
+	CreatedAt      string                   `json:"created_at"`
+	Archived       bool                     `json:"archived"`
+	Parameters     ExperimentParameters     `json:"parameters"`
+	Filters        map[string]interface{}   `json:"filters"` // legacy primary metric definition
+	Metrics        []map[string]interface{} `json:"metrics"`
+}
+
+// Status derives the experiment's lifecycle stage from its start and end dates
+func (e Experiment) Status() ExperimentStatus {
+	switch {
+	case e.StartDate == nil:
+		return ExperimentDraft
+	case e.EndDate == nil:
+		return ExperimentRunning
+	default:
+		return ExperimentComplete
+	}
+}
+
+// PrimaryMetric describes the experiment's primary metric, from its metrics
+// list or, for older experiments, from the legacy insight filters
+func (e Experiment) PrimaryMetric() string {
+	if len(e.Metrics) > 0 {
+		metric := e.Metrics[0]
+		if name, ok := metric["name"].(string); ok && name != "" {
+			return name
+		}
+		if kind, ok := metric["metric_type"].(string); ok && kind != "" {
+			return kind
+		}
+		if kind, ok := metric["kind"].(string); ok {
+			return kind
+		}
+	}
+
+	if len(e.Filters) > 0 {
+		insight, _ := e.Filters["insight"].(string)
+		var names []string
+		for _, key := range []string{"events", "actions"} {
+			entities, _ := e.Filters[key].([]interface{})
+			for _, entity := range entities {
+				if m, ok := entity.(map[string]interface{}); ok {
+					if name, ok := m["name"].(string); ok {
+						names = append(names, name)
+					}
+				}
+			}
+		}
+		if len(names) > 0 {
+			desc := fmt.Sprintf("%v", names)
+			if insight != "" {
+				desc = insight + " " + desc
+			}
+			return desc
+		}
+		if insight != "" {
+			return insight
+		}
+	}
+
+	return ""
+}
+
+// ExperimentsResponse represents the API response for experiments list
+type ExperimentsResponse struct {
+	Next     *string      `json:"next"`
+	Previous *string      `json:"previous"`
+	Results  []Experiment `json:"results"`
+}
+
+// ExperimentResults holds the current results of an experiment as computed by PostHog
+type ExperimentResults struct {
+	Probability       map[string]float64       `json:"probability"`
+	Significant       bool                     `json:"significant"`
+	SignificanceCode  string                   `json:"significance_code"`
+	ExpectedLoss      float64                  `json:"expected_loss"`
+	PValue            float64                  `json:"p_value"`
+	CredibleIntervals map[string][]float64     `json:"credible_intervals"`
+	Variants          []map[string]interface{} `json:"variants"`
+	LastRefresh       string                   `json:"last_refresh"`
+}
+
+// ListExperiments fetches all experiments, following pagination
+func (c *Client) ListExperiments(ctx context.Context) ([]Experiment, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("ListExperiments: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/experiments/", c.getProjectPath())
+
+	var experiments []Experiment
+	for path != "" {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("ListExperiments: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var experimentsResp ExperimentsResponse
+		if err := json.Unmarshal(body, &experimentsResp); err != nil {
+			return nil, fmt.Errorf("failed to parse experiments response: %w", err)
+		}
+
+		experiments = append(experiments, experimentsResp.Results...)
+		path = nextPagePath(experimentsResp.Next)
+	}
+
+	return experiments, nil
+}
+
+// GetExperimentResults fetches the current results of an experiment
+func (c *Client) GetExperimentResults(ctx context.Context, experimentID int) (*ExperimentResults, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("GetExperimentResults: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/experiments/%d/results/", c.getProjectPath(), experimentID)
+
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("GetExperimentResults: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var resultsResp struct {
+		Result *ExperimentResults `json:"result"`
+	}
+	if err := json.Unmarshal(body, &resultsResp); err != nil {
+		return nil, fmt.Errorf("failed to parse experiment results response: %w", err)
+	}
+
+	if resultsResp.Result == nil {
+		return nil, fmt.Errorf("no results available for experiment %d", experimentID)
+	}
+
+	return resultsResp.Result, nil
+}
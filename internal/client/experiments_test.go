@@ -0,0 +1,49 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestExperiment_Status(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want ExperimentStatus
+	}{
+		{"draft", `{"start_date": null, "end_date": null}`, ExperimentDraft},
+		{"running", `{"start_date": "2024-01-01T00:00:00Z", "end_date": null}`, ExperimentRunning},
+		{"complete", `{"start_date": "2024-01-01T00:00:00Z", "end_date": "2024-02-01T00:00:00Z"}`, ExperimentComplete},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var exp Experiment
+			if err := json.Unmarshal([]byte(tt.json), &exp); err != nil {
+				t.Fatalf("failed to unmarshal experiment: %v", err)
+			}
+			if got := exp.Status(); got != tt.want {
+				t.Errorf("Status() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExperiment_PrimaryMetric(t *testing.T) {
+	legacy := Experiment{Filters: map[string]interface{}{
+		"insight": "FUNNELS",
+		"events":  []interface{}{map[string]interface{}{"name": "$pageview"}, map[string]interface{}{"name": "purchase"}},
+	}}
+	if got := legacy.PrimaryMetric(); got != "FUNNELS [$pageview purchase]" {
+		t.Errorf("PrimaryMetric() = %q, want legacy funnel description", got)
+	}
+
+	named := Experiment{Metrics: []map[string]interface{}{{"name": "Checkout conversion", "kind": "ExperimentMetric"}}}
+	if got := named.PrimaryMetric(); got != "Checkout conversion" {
+		t.Errorf("PrimaryMetric() = %q, want metric name", got)
+	}
+
+	if got := (Experiment{}).PrimaryMetric(); got != "" {
+		t.Errorf("PrimaryMetric() = %q, want empty", got)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"time"
 )
 
 // FeatureFlag represents a PostHog feature flag
@@ -20,6 +21,64 @@ type FeatureFlag struct {
 	EnsureExperience bool                   `json:"ensure_experience_continuity"`
 }
 
+// RolloutState classifies how widely a feature flag is released
+type RolloutState string
+
+const (
+	RolloutOff     RolloutState = "off"     // flag is inactive
+	RolloutFull    RolloutState = "100%"    // active with an unconditional 100% release condition
+	RolloutPartial RolloutState = "partial" // active with targeting or a partial rollout
+)
+
+// RolloutState reports whether the flag is fully off, fully rolled out or partial.
+// Multivariate flags are only considered fully rolled out if one variant gets 100%.
+func (f FeatureFlag) RolloutState() RolloutState {
+	if !f.Active {
+		return RolloutOff
+	}
+
+	if multivariate, ok := f.Filters["multivariate"].(map[string]interface{}); ok {
+		variants, _ := multivariate["variants"].([]interface{})
+		if len(variants) > 0 {
+			full := false
+			for _, v := range variants {
+				variant, _ := v.(map[string]interface{})
+				if pct, ok := variant["rollout_percentage"].(float64); ok && pct >= 100 {
+					full = true
+				}
+			}
+			if !full {
+				return RolloutPartial
+			}
+		}
+	}
+
+	groups, _ := f.Filters["groups"].([]interface{})
+	for _, g := range groups {
+		group, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if props, _ := group["properties"].([]interface{}); len(props) > 0 {
+			continue
+		}
+		// A missing rollout percentage means everyone matching the condition
+		pct, hasPct := group["rollout_percentage"].(float64)
+		if !hasPct || pct >= 100 {
+			return RolloutFull
+		}
+	}
+
+	return RolloutPartial
+}
+
+// FlagUsage summarizes how often a flag was evaluated, from $feature_flag_called events
+type FlagUsage struct {
+	Key           string
+	Evaluations   int
+	LastEvaluated time.Time
+}
+
 // FlagsResponse represents the API response for feature flags list
 type FlagsResponse struct {
 	Next     *string       `json:"next"`
@@ -27,7 +86,7 @@ type FlagsResponse struct {
 	Results  []FeatureFlag `json:"results"`
 }
 
-// ListFlags fetches all feature flags
+// ListFlags fetches all feature flags, following pagination
 func (c *Client) ListFlags(ctx context.Context) ([]FeatureFlag, error) {
 	if err := c.ensureProjectInitialized(ctx); err != nil {
 		return nil, fmt.Errorf("ListFlags: %w", err)
@@ -35,23 +94,29 @@ func (c *Client) ListFlags(ctx context.Context) ([]FeatureFlag, error) {
 
 	path := fmt.Sprintf("%s/feature_flags/", c.getProjectPath())
 
-	resp, err := c.get(ctx, path)
-	if err != nil {
-		return nil, fmt.Errorf("ListFlags: %w", err)
+	var flags []FeatureFlag
+	for path != "" {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("ListFlags: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var flagsResp FlagsResponse
+		if err := json.Unmarshal(body, &flagsResp); err != nil {
+			return nil, fmt.Errorf("failed to parse flags response: %w", err)
+		}
+
+		flags = append(flags, flagsResp.Results...)
+		path = nextPagePath(flagsResp.Next)
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	var flagsResp FlagsResponse
-	if err := json.Unmarshal(body, &flagsResp); err != nil {
-		return nil, fmt.Errorf("failed to parse flags response: %w", err)
-	}
-
-	return flagsResp.Results, nil
+	return flags, nil
 }
 
 // ToggleFlag updates a feature flag's active status
@@ -101,3 +166,37 @@ func (c *Client) GetFlag(ctx context.Context, flagID int) (*FeatureFlag, error)
 
 	return &flag, nil
 }
+
+// GetFlagUsage counts $feature_flag_called events per flag key over the last
+// days days using HogQL. Flags that were not evaluated are absent from the map.
+func (c *Client) GetFlagUsage(ctx context.Context, days int) (map[string]FlagUsage, error) {
+	if days <= 0 {
+		days = 30
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			properties.$feature_flag AS flag,
+			count() AS evaluations,
+			max(timestamp) AS last_evaluated
+		FROM events
+		WHERE event = '$feature_flag_called'
+			AND timestamp > now() - INTERVAL %d DAY
+		GROUP BY flag
+		LIMIT 10000
+	`, days)
+
+	result, err := c.ExecuteQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query flag usage: %w", err)
+	}
+
+	usage := make(map[string]FlagUsage, len(result.Results))
+	for _, row := range result.Results {
+		if u, ok := parseFlagUsageFromRow(row); ok {
+			usage[u.Key] = u
+		}
+	}
+
+	return usage, nil
+}
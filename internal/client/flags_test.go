@@ -0,0 +1,66 @@
+package client
+
+import "testing"
+
+func TestFeatureFlag_RolloutState(t *testing.T) {
+	group := func(pct interface{}, props ...interface{}) interface{} {
+		g := map[string]interface{}{"properties": append([]interface{}{}, props...)}
+		if pct != nil {
+			g["rollout_percentage"] = pct
+		}
+		return g
+	}
+
+	tests := []struct {
+		name string
+		flag FeatureFlag
+		want RolloutState
+	}{
+		{
+			name: "inactive",
+			flag: FeatureFlag{Active: false, Filters: map[string]interface{}{"groups": []interface{}{group(float64(100))}}},
+			want: RolloutOff,
+		},
+		{
+			name: "unconditional 100%",
+			flag: FeatureFlag{Active: true, Filters: map[string]interface{}{"groups": []interface{}{group(float64(100))}}},
+			want: RolloutFull,
+		},
+		{
+			name: "null rollout means everyone",
+			flag: FeatureFlag{Active: true, Filters: map[string]interface{}{"groups": []interface{}{group(nil)}}},
+			want: RolloutFull,
+		},
+		{
+			name: "partial rollout",
+			flag: FeatureFlag{Active: true, Filters: map[string]interface{}{"groups": []interface{}{group(float64(50))}}},
+			want: RolloutPartial,
+		},
+		{
+			name: "targeted 100%",
+			flag: FeatureFlag{Active: true, Filters: map[string]interface{}{"groups": []interface{}{
+				group(float64(100), map[string]interface{}{"key": "email"}),
+			}}},
+			want: RolloutPartial,
+		},
+		{
+			name: "multivariate split",
+			flag: FeatureFlag{Active: true, Filters: map[string]interface{}{
+				"groups": []interface{}{group(float64(100))},
+				"multivariate": map[string]interface{}{"variants": []interface{}{
+					map[string]interface{}{"key": "a", "rollout_percentage": float64(50)},
+					map[string]interface{}{"key": "b", "rollout_percentage": float64(50)},
+				}},
+			}},
+			want: RolloutPartial,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.flag.RolloutState(); got != tt.want {
+				t.Errorf("RolloutState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
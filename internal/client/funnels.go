@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// FunnelWindow is how long a person has to complete a funnel after its first step
+type FunnelWindow struct {
+	Interval int
+	Unit     string // "minute", "hour", "day" or "week"
+}
+
+// String returns the window in words, e.g. "14 days"
+func (w FunnelWindow) String() string {
+	if w.Interval == 1 {
+		return fmt.Sprintf("1 %s", w.Unit)
+	}
+	return fmt.Sprintf("%d %ss", w.Interval, w.Unit)
+}
+
+// funnelsQuery is a FunnelsQuery for the Query API
+type funnelsQuery struct {
+	Kind          string                   `json:"kind"`
+	Series        []map[string]interface{} `json:"series"`
+	DateRange     map[string]string        `json:"dateRange"`
+	FunnelsFilter map[string]interface{}   `json:"funnelsFilter"`
+}
+
+// RunFunnel runs an ordered funnel over the given events. dateFrom is a
+// relative date such as "-7d"; window is the conversion window.
+func (c *Client) RunFunnel(ctx context.Context, events []string, dateFrom string, window FunnelWindow) ([]FunnelStep, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("RunFunnel: %w", err)
+	}
+
+	series := make([]map[string]interface{}, len(events))
+	for i, event := range events {
+		series[i] = map[string]interface{}{
+			"kind":  "EventsNode",
+			"event": event,
+			"name":  event,
+		}
+	}
+
+	reqData := map[string]interface{}{
+		"query": funnelsQuery{
+			Kind:      "FunnelsQuery",
+			Series:    series,
+			DateRange: map[string]string{"date_from": dateFrom},
+			FunnelsFilter: map[string]interface{}{
+				"funnelWindowInterval":     window.Interval,
+				"funnelWindowIntervalUnit": window.Unit,
+				"funnelOrderType":          "ordered",
+			},
+		},
+	}
+
+	path := fmt.Sprintf("%s/query/", c.getProjectPath())
+
+	resp, err := c.post(ctx, path, reqData)
+	if err != nil {
+		return nil, fmt.Errorf("RunFunnel: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var funnelResp struct {
+		Results json.RawMessage `json:"results"`
+		Error   string          `json:"error"`
+	}
+	if err := json.Unmarshal(body, &funnelResp); err != nil {
+		return nil, fmt.Errorf("failed to parse funnel response: %w", err)
+	}
+
+	if funnelResp.Error != "" {
+		return nil, fmt.Errorf("query error: %s", funnelResp.Error)
+	}
+
+	return parseFunnelSteps(funnelResp.Results)
+}
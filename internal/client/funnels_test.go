@@ -0,0 +1,19 @@
+package client
+
+import "testing"
+
+func TestFunnelWindow_String(t *testing.T) {
+	tests := []struct {
+		window FunnelWindow
+		want   string
+	}{
+		{FunnelWindow{Interval: 1, Unit: "hour"}, "1 hour"},
+		{FunnelWindow{Interval: 14, Unit: "day"}, "14 days"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.window.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// maxGroupTypeIndex is the highest group type index PostHog supports
+const maxGroupTypeIndex = 4
+
+// GroupType is a configured kind of group, e.g. company or workspace
+type GroupType struct {
+	Index        int     `json:"group_type_index"`
+	Type         string  `json:"group_type"`
+	NameSingular *string `json:"name_singular"`
+	NamePlural   *string `json:"name_plural"`
+}
+
+// Plural returns the group type's display name for several groups
+func (t GroupType) Plural() string {
+	if t.NamePlural != nil && *t.NamePlural != "" {
+		return *t.NamePlural
+	}
+	return t.Type
+}
+
+// Group is an entity, such as a company, that events are associated with
+type Group struct {
+	TypeIndex  int                    `json:"group_type_index"`
+	Key        string                 `json:"group_key"`
+	Properties map[string]interface{} `json:"group_properties"`
+	CreatedAt  string                 `json:"created_at"`
+}
+
+// Name returns the group's "name" property, or its key if it has none
+func (g Group) Name() string {
+	if name, ok := g.Properties["name"].(string); ok && name != "" {
+		return name
+	}
+	return g.Key
+}
+
+// GroupsResponse represents the API response for groups list
+type GroupsResponse struct {
+	Next    *string `json:"next"`
+	Results []Group `json:"results"`
+}
+
+// GroupMember is a person who sent events associated with a group
+type GroupMember struct {
+	PersonID   string
+	DistinctID string
+	Email      string
+	Events     int
+	LastSeen   time.Time
+}
+
+// GroupColumn returns the events column holding the key of the group of
+// a type, e.g. "$group_0"
+func GroupColumn(typeIndex int) (string, error) {
+	if typeIndex < 0 || typeIndex > maxGroupTypeIndex {
+		return "", fmt.Errorf("invalid group type index %d", typeIndex)
+	}
+	return fmt.Sprintf("$group_%d", typeIndex), nil
+}
+
+// ListGroupTypes fetches the project's configured group types
+func (c *Client) ListGroupTypes(ctx context.Context) ([]GroupType, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("ListGroupTypes: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/groups_types/", c.getProjectPath())
+
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("ListGroupTypes: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var groupTypes []GroupType
+	if err := json.Unmarshal(body, &groupTypes); err != nil {
+		return nil, fmt.Errorf("failed to parse group types response: %w", err)
+	}
+
+	return groupTypes, nil
+}
+
+// ListGroups fetches up to limit groups of a type
+func (c *Client) ListGroups(ctx context.Context, typeIndex, limit int) ([]Group, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("ListGroups: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/groups/?group_type_index=%d", c.getProjectPath(), typeIndex)
+
+	var groups []Group
+	for path != "" && len(groups) < limit {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("ListGroups: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var groupsResp GroupsResponse
+		if err := json.Unmarshal(body, &groupsResp); err != nil {
+			return nil, fmt.Errorf("failed to parse groups response: %w", err)
+		}
+
+		groups = append(groups, groupsResp.Results...)
+		path = nextPagePath(groupsResp.Next)
+	}
+
+	if len(groups) > limit {
+		groups = groups[:limit]
+	}
+	return groups, nil
+}
+
+// GetGroup fetches a group by type and key
+func (c *Client) GetGroup(ctx context.Context, typeIndex int, key string) (*Group, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("GetGroup: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/groups/find/?group_type_index=%d&group_key=%s", c.getProjectPath(), typeIndex, url.QueryEscape(key))
+
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("GetGroup: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var group Group
+	if err := json.Unmarshal(body, &group); err != nil {
+		return nil, fmt.Errorf("failed to parse group response: %w", err)
+	}
+
+	return &group, nil
+}
+
+// ListGroupMembers fetches the persons who sent events associated with a
+// group in the last 90 days, most recently seen first
+func (c *Client) ListGroupMembers(ctx context.Context, typeIndex int, key string, limit int) ([]GroupMember, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	column, err := GroupColumn(typeIndex)
+	if err != nil {
+		return nil, fmt.Errorf("ListGroupMembers: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			person_id,
+			any(distinct_id) AS distinct_id,
+			any(%s) AS email,
+			count() AS events,
+			max(timestamp) AS last_seen
+		FROM events
+		WHERE %s = %s
+			AND timestamp > now() - INTERVAL 90 DAY
+		GROUP BY person_id
+		ORDER BY last_seen DESC
+		LIMIT %d
+	`, HogQLPersonProperty("email"), column, QuoteHogQLString(key), limit)
+
+	result, err := c.ExecuteQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query group members: %w", err)
+	}
+
+	members := make([]GroupMember, 0, len(result.Results))
+	for _, row := range result.Results {
+		if member, ok := parseGroupMemberFromRow(row); ok {
+			members = append(members, member)
+		}
+	}
+
+	return members, nil
+}
+
+// ListGroupEvents fetches the most recent events associated with a group
+func (c *Client) ListGroupEvents(ctx context.Context, typeIndex int, key string, limit int) ([]Event, error) {
+	column, err := GroupColumn(typeIndex)
+	if err != nil {
+		return nil, fmt.Errorf("ListGroupEvents: %w", err)
+	}
+	return c.listEvents(ctx, fmt.Sprintf("WHERE %s = %s", column, QuoteHogQLString(key)), limit)
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"regexp"
+	"strings"
 )
 
 // QueryResult represents a HogQL query result
@@ -89,3 +91,34 @@ func (c *Client) ExecuteQuery(ctx context.Context, query string) (*QueryResult,
 
 	return result, nil
 }
+
+// QuoteHogQLString quotes a value as a HogQL string literal, escaping
+// backslashes and single quotes
+func QuoteHogQLString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return "'" + value + "'"
+}
+
+// simplePropertyKey matches property keys usable as HogQL field access
+var simplePropertyKey = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// HogQLProperty returns a HogQL expression reading an event property,
+// e.g. "properties.$browser", extracting keys with other characters as strings
+func HogQLProperty(key string) string {
+	return hogQLField("properties", key)
+}
+
+// HogQLPersonProperty returns a HogQL expression reading a property of the
+// event's person, e.g. "person.properties.email"
+func HogQLPersonProperty(key string) string {
+	return hogQLField("person.properties", key)
+}
+
+// hogQLField reads key from a JSON column such as properties
+func hogQLField(column, key string) string {
+	if simplePropertyKey.MatchString(key) {
+		return column + "." + key
+	}
+	return fmt.Sprintf("JSONExtractString(%s, %s)", column, QuoteHogQLString(key))
+}
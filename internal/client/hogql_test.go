@@ -0,0 +1,42 @@
+package client
+
+import "testing"
+
+func TestQuoteHogQLString(t *testing.T) {
+	tests := map[string]string{
+		"user-1":       `'user-1'`,
+		"o'brien":      `'o\'brien'`,
+		`back\slash`:   `'back\\slash'`,
+		`\' OR 1=1 --`: `'\\\' OR 1=1 --'`,
+	}
+
+	for input, want := range tests {
+		if got := QuoteHogQLString(input); got != want {
+			t.Errorf("QuoteHogQLString(%q) = %s, want %s", input, got, want)
+		}
+	}
+}
+
+func TestHogQLProperty(t *testing.T) {
+	tests := map[string]string{
+		"$browser":   "properties.$browser",
+		"plan":       "properties.plan",
+		"utm-source": "JSONExtractString(properties, 'utm-source')",
+		"Plan Name":  "JSONExtractString(properties, 'Plan Name')",
+	}
+
+	for key, want := range tests {
+		if got := HogQLProperty(key); got != want {
+			t.Errorf("HogQLProperty(%q) = %s, want %s", key, got, want)
+		}
+	}
+}
+
+func TestHogQLPersonProperty(t *testing.T) {
+	if got, want := HogQLPersonProperty("email"), "person.properties.email"; got != want {
+		t.Errorf("HogQLPersonProperty() = %s, want %s", got, want)
+	}
+	if got, want := HogQLPersonProperty("first name"), "JSONExtractString(person.properties, 'first name')"; got != want {
+		t.Errorf("HogQLPersonProperty() = %s, want %s", got, want)
+	}
+}
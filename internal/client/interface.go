@@ -1,23 +1,90 @@
 package client
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // PostHogClient defines the interface for PostHog API operations.
 // This interface enables testability by allowing mock implementations.
 type PostHogClient interface {
 	// Events
 	ListRecentEvents(ctx context.Context, limit int) ([]Event, error)
+	ListRecentEventsMatching(ctx context.Context, condition string, limit int) ([]Event, error)
+	CountEventsMatching(ctx context.Context, condition string, window time.Duration) (int, error)
 	GetEvent(ctx context.Context, eventID string) (*Event, error)
 
 	// Persons
 	GetPerson(ctx context.Context, distinctID string) (*Person, error)
-	GetPersonEvents(ctx context.Context, distinctID string, limit int) ([]Event, error)
+	GetPersonEvents(ctx context.Context, distinctID string, limit, offset int) ([]Event, error)
 	ListPersons(ctx context.Context, limit int) ([]Person, error)
+	SearchPersons(ctx context.Context, search PersonSearch, limit int) ([]Person, error)
+	GetPersonPropertyUpdates(ctx context.Context, personUUID string, limit int) ([]PersonPropertyUpdate, error)
 
 	// Feature Flags
 	ListFlags(ctx context.Context) ([]FeatureFlag, error)
 	GetFlag(ctx context.Context, flagID int) (*FeatureFlag, error)
 	ToggleFlag(ctx context.Context, flagID int, active bool) error
+	GetFlagActivity(ctx context.Context, flagID int) ([]ActivityLogEntry, error)
+	GetFlagUsage(ctx context.Context, days int) (map[string]FlagUsage, error)
+
+	// Experiments
+	ListExperiments(ctx context.Context) ([]Experiment, error)
+	GetExperimentResults(ctx context.Context, experimentID int) (*ExperimentResults, error)
+
+	// Cohorts
+	ListCohorts(ctx context.Context) ([]Cohort, error)
+	ListCohortPersons(ctx context.Context, cohortID, limit, offset int) ([]Person, bool, error)
+	GetPersonCohorts(ctx context.Context, personUUID string) ([]Cohort, error)
+	CreateStaticCohort(ctx context.Context, name string) (*Cohort, error)
+	AddPersonsToStaticCohort(ctx context.Context, cohortID int, personUUIDs []string) error
+	ResolvePersonUUIDs(ctx context.Context, distinctIDs []string) ([]string, error)
+
+	// Trends
+	GetEventTrend(ctx context.Context, event string, window time.Duration, interval TrendInterval, breakdownProperty string) ([]TrendPoint, error)
+	GetPropertyBreakdown(ctx context.Context, event, property string, window time.Duration, limit int) (*PropertyBreakdown, error)
+
+	// Funnels
+	RunFunnel(ctx context.Context, events []string, dateFrom string, window FunnelWindow) ([]FunnelStep, error)
+
+	// Schema
+	ListEventDefinitions(ctx context.Context) ([]EventDefinition, error)
+	ListEventProperties(ctx context.Context, event string) ([]PropertyDefinition, error)
+	UpdateEventDefinition(ctx context.Context, id string, update DefinitionUpdate) (*EventDefinition, error)
+	UpdatePropertyDefinition(ctx context.Context, id string, update DefinitionUpdate) (*PropertyDefinition, error)
+	ListOrganizationMembers(ctx context.Context) ([]DefinitionOwner, error)
+
+	// Actions
+	ListActions(ctx context.Context) ([]Action, error)
+
+	// Groups
+	ListGroupTypes(ctx context.Context) ([]GroupType, error)
+	ListGroups(ctx context.Context, typeIndex, limit int) ([]Group, error)
+	GetGroup(ctx context.Context, typeIndex int, key string) (*Group, error)
+	ListGroupMembers(ctx context.Context, typeIndex int, key string, limit int) ([]GroupMember, error)
+	ListGroupEvents(ctx context.Context, typeIndex int, key string, limit int) ([]Event, error)
+
+	// Error tracking
+	ListExceptionGroups(ctx context.Context, days, limit int) ([]ExceptionGroup, error)
+	GetExceptionTrend(ctx context.Context, key string, days int) ([]ExceptionTrendPoint, error)
+	GetLatestException(ctx context.Context, key string) (*Event, error)
+
+	// Session recordings
+	ListPersonRecordings(ctx context.Context, personUUID string, limit int) ([]SessionRecording, error)
+	ReplayURL(sessionID string) string
+
+	// Surveys
+	ListSurveys(ctx context.Context) ([]Survey, error)
+	CountSurveyResponses(ctx context.Context) (map[string]int, error)
+	ListSurveyResponses(ctx context.Context, surveyID string, limit int) ([]Event, error)
+
+	// Annotations
+	ListAnnotations(ctx context.Context) ([]Annotation, error)
+	CreateAnnotation(ctx context.Context, content string, date time.Time) (*Annotation, error)
+
+	// Dashboards
+	ListDashboards(ctx context.Context) ([]Dashboard, error)
+	GetDashboard(ctx context.Context, dashboardID int) (*Dashboard, error)
 
 	// Projects
 	FetchProjects(ctx context.Context) ([]Project, error)
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -48,3 +50,229 @@ func parseEventFromRow(row []interface{}) (Event, bool) {
 
 	return event, true
 }
+
+// parseFlagUsageFromRow parses a flag usage query row into a FlagUsage struct.
+// Expected column order: flag, evaluations, last_evaluated
+func parseFlagUsageFromRow(row []interface{}) (FlagUsage, bool) {
+	if len(row) < 3 {
+		return FlagUsage{}, false
+	}
+
+	key, ok := row[0].(string)
+	if !ok || key == "" {
+		return FlagUsage{}, false
+	}
+
+	usage := FlagUsage{Key: key}
+
+	// Counts are decoded from JSON as float64
+	if count, ok := row[1].(float64); ok {
+		usage.Evaluations = int(count)
+	}
+
+	if ts, ok := row[2].(string); ok {
+		if parsed, err := time.Parse(time.RFC3339, ts); err == nil {
+			usage.LastEvaluated = parsed
+		}
+	}
+
+	return usage, true
+}
+
+// parseTrendPointFromRow parses a trend query row into a TrendPoint struct.
+// Expected column order: bucket, count[, breakdown]
+func parseTrendPointFromRow(row []interface{}) (TrendPoint, bool) {
+	if len(row) < 2 {
+		return TrendPoint{}, false
+	}
+
+	ts, ok := row[0].(string)
+	if !ok {
+		return TrendPoint{}, false
+	}
+	bucket, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return TrendPoint{}, false
+	}
+
+	point := TrendPoint{Bucket: bucket}
+
+	// Counts are decoded from JSON as float64
+	if count, ok := row[1].(float64); ok {
+		point.Count = count
+	}
+
+	if len(row) > 2 && row[2] != nil {
+		point.Breakdown = fmt.Sprintf("%v", row[2])
+	}
+
+	return point, true
+}
+
+// parsePropertyValueCountFromRow parses a property breakdown row into a
+// PropertyValueCount and the total count across all values.
+// Expected column order: value, count, total
+func parsePropertyValueCountFromRow(row []interface{}) (PropertyValueCount, float64, bool) {
+	if len(row) < 3 {
+		return PropertyValueCount{}, 0, false
+	}
+
+	value := PropertyValueCount{}
+	if row[0] != nil {
+		value.Value = fmt.Sprintf("%v", row[0])
+	}
+
+	// Counts are decoded from JSON as float64
+	count, ok := row[1].(float64)
+	if !ok {
+		return PropertyValueCount{}, 0, false
+	}
+	value.Count = count
+
+	total, _ := row[2].(float64)
+	return value, total, true
+}
+
+// parseExceptionGroupFromRow parses an exception group query row into an
+// ExceptionGroup struct.
+// Expected column order: issue, type, message, occurrences, users, first_seen, last_seen
+func parseExceptionGroupFromRow(row []interface{}) (ExceptionGroup, bool) {
+	if len(row) < 7 {
+		return ExceptionGroup{}, false
+	}
+
+	key, ok := row[0].(string)
+	if !ok || key == "" {
+		return ExceptionGroup{}, false
+	}
+
+	group := ExceptionGroup{Key: key}
+	group.Type, _ = row[1].(string)
+	group.Message, _ = row[2].(string)
+
+	// Counts are decoded from JSON as float64
+	if count, ok := row[3].(float64); ok {
+		group.Occurrences = int(count)
+	}
+	if count, ok := row[4].(float64); ok {
+		group.Users = int(count)
+	}
+
+	if ts, ok := row[5].(string); ok {
+		if parsed, err := time.Parse(time.RFC3339, ts); err == nil {
+			group.FirstSeen = parsed
+		}
+	}
+	if ts, ok := row[6].(string); ok {
+		if parsed, err := time.Parse(time.RFC3339, ts); err == nil {
+			group.LastSeen = parsed
+		}
+	}
+
+	return group, true
+}
+
+// parseExceptionTrendPointFromRow parses an exception trend query row into an
+// ExceptionTrendPoint struct.
+// Expected column order: day, occurrences, users
+func parseExceptionTrendPointFromRow(row []interface{}) (ExceptionTrendPoint, bool) {
+	if len(row) < 3 {
+		return ExceptionTrendPoint{}, false
+	}
+
+	ts, ok := row[0].(string)
+	if !ok {
+		return ExceptionTrendPoint{}, false
+	}
+	day, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return ExceptionTrendPoint{}, false
+	}
+
+	point := ExceptionTrendPoint{Day: day}
+
+	// Counts are decoded from JSON as float64
+	if count, ok := row[1].(float64); ok {
+		point.Occurrences = int(count)
+	}
+	if count, ok := row[2].(float64); ok {
+		point.Users = int(count)
+	}
+
+	return point, true
+}
+
+// parseGroupMemberFromRow parses a group members query row into a
+// GroupMember struct.
+// Expected column order: person_id, distinct_id, email, events, last_seen
+func parseGroupMemberFromRow(row []interface{}) (GroupMember, bool) {
+	if len(row) < 5 {
+		return GroupMember{}, false
+	}
+
+	personID, ok := row[0].(string)
+	if !ok || personID == "" {
+		return GroupMember{}, false
+	}
+
+	member := GroupMember{PersonID: personID}
+	member.DistinctID, _ = row[1].(string)
+	member.Email, _ = row[2].(string)
+
+	// Counts are decoded from JSON as float64
+	if count, ok := row[3].(float64); ok {
+		member.Events = int(count)
+	}
+
+	if ts, ok := row[4].(string); ok {
+		if parsed, err := time.Parse(time.RFC3339, ts); err == nil {
+			member.LastSeen = parsed
+		}
+	}
+
+	return member, true
+}
+
+// parsePersonPropertyUpdateFromRow parses a person property updates query
+// row into a PersonPropertyUpdate struct. The payloads are raw JSON, empty
+// if the event doesn't carry them.
+// Expected column order: uuid, event, timestamp, set, set_once, unset
+func parsePersonPropertyUpdateFromRow(row []interface{}) (PersonPropertyUpdate, bool) {
+	if len(row) < 6 {
+		return PersonPropertyUpdate{}, false
+	}
+
+	update := PersonPropertyUpdate{}
+	update.UUID, _ = row[0].(string)
+	update.Event, _ = row[1].(string)
+
+	if ts, ok := row[2].(string); ok {
+		if parsed, err := time.Parse(time.RFC3339, ts); err == nil {
+			update.Timestamp = parsed
+		}
+	}
+
+	if raw, ok := row[3].(string); ok && raw != "" {
+		_ = json.Unmarshal([]byte(raw), &update.Set)
+	}
+	if raw, ok := row[4].(string); ok && raw != "" {
+		_ = json.Unmarshal([]byte(raw), &update.SetOnce)
+	}
+
+	// $unset is a list of keys, though some SDKs send an object
+	if raw, ok := row[5].(string); ok && raw != "" {
+		if err := json.Unmarshal([]byte(raw), &update.Unset); err != nil {
+			var keys map[string]interface{}
+			if json.Unmarshal([]byte(raw), &keys) == nil {
+				for key := range keys {
+					update.Unset = append(update.Unset, key)
+				}
+			}
+		}
+	}
+
+	if len(update.Set) == 0 && len(update.SetOnce) == 0 && len(update.Unset) == 0 {
+		return PersonPropertyUpdate{}, false
+	}
+	return update, true
+}
@@ -155,3 +155,126 @@ func TestParseEventFromRow_IDEqualsUUID(t *testing.T) {
 		t.Errorf("parseEventFromRow() ID (%v) should equal UUID (%v)", event.ID, event.UUID)
 	}
 }
+
+func TestParseFlagUsageFromRow(t *testing.T) {
+	row := []interface{}{"new-checkout", float64(1234), "2024-01-15T10:30:00.123Z"}
+
+	usage, ok := parseFlagUsageFromRow(row)
+	if !ok {
+		t.Fatal("parseFlagUsageFromRow() returned false")
+	}
+
+	if usage.Key != "new-checkout" {
+		t.Errorf("parseFlagUsageFromRow() Key = %v, want new-checkout", usage.Key)
+	}
+	if usage.Evaluations != 1234 {
+		t.Errorf("parseFlagUsageFromRow() Evaluations = %d, want 1234", usage.Evaluations)
+	}
+
+	expected := time.Date(2024, 1, 15, 10, 30, 0, 123000000, time.UTC)
+	if !usage.LastEvaluated.Equal(expected) {
+		t.Errorf("parseFlagUsageFromRow() LastEvaluated = %v, want %v", usage.LastEvaluated, expected)
+	}
+}
+
+func TestParseFlagUsageFromRow_Invalid(t *testing.T) {
+	rows := [][]interface{}{
+		{},
+		{"key", float64(1)},
+		{nil, float64(1), "2024-01-15T10:30:00Z"},
+		{"", float64(1), "2024-01-15T10:30:00Z"},
+	}
+
+	for _, row := range rows {
+		if _, ok := parseFlagUsageFromRow(row); ok {
+			t.Errorf("parseFlagUsageFromRow(%v) ok = true, want false", row)
+		}
+	}
+}
+
+func TestParseTrendPointFromRow(t *testing.T) {
+	row := []interface{}{"2024-01-15T10:00:00Z", float64(42), "Chrome"}
+
+	point, ok := parseTrendPointFromRow(row)
+	if !ok {
+		t.Fatal("parseTrendPointFromRow() returned false")
+	}
+
+	expected := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	if !point.Bucket.Equal(expected) || point.Count != 42 || point.Breakdown != "Chrome" {
+		t.Errorf("parseTrendPointFromRow() = %+v, want 42 Chrome events at %v", point, expected)
+	}
+
+	if _, ok := parseTrendPointFromRow([]interface{}{"not a time", float64(1)}); ok {
+		t.Error("parseTrendPointFromRow() with invalid bucket ok = true, want false")
+	}
+}
+
+func TestParsePropertyValueCountFromRow(t *testing.T) {
+	value, total, ok := parsePropertyValueCountFromRow([]interface{}{nil, float64(3), float64(10)})
+	if !ok {
+		t.Fatal("parsePropertyValueCountFromRow() returned false")
+	}
+	if value.Value != "" || value.Count != 3 || total != 10 {
+		t.Errorf("parsePropertyValueCountFromRow() = %+v, %v, want unset value with 3 of 10", value, total)
+	}
+
+	if _, _, ok := parsePropertyValueCountFromRow([]interface{}{"Chrome", "x", float64(10)}); ok {
+		t.Error("parsePropertyValueCountFromRow() with invalid count ok = true, want false")
+	}
+}
+
+func TestParseExceptionGroupFromRow(t *testing.T) {
+	row := []interface{}{"TypeError: x is undefined", "TypeError", "x is undefined", float64(12), float64(5),
+		"2024-01-14T08:00:00Z", "2024-01-15T10:00:00Z"}
+
+	group, ok := parseExceptionGroupFromRow(row)
+	if !ok {
+		t.Fatal("parseExceptionGroupFromRow() returned false")
+	}
+	if group.Type != "TypeError" || group.Occurrences != 12 || group.Users != 5 {
+		t.Errorf("parseExceptionGroupFromRow() = %+v, want 12 TypeErrors affecting 5 users", group)
+	}
+	if want := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC); !group.LastSeen.Equal(want) {
+		t.Errorf("LastSeen = %v, want %v", group.LastSeen, want)
+	}
+
+	if _, ok := parseExceptionGroupFromRow([]interface{}{nil, "", "", float64(1), float64(1), "", ""}); ok {
+		t.Error("parseExceptionGroupFromRow() without a group key ok = true, want false")
+	}
+}
+
+func TestParseGroupMemberFromRow(t *testing.T) {
+	row := []interface{}{"person-1", "user-1", nil, float64(8), "2024-01-15T10:00:00Z"}
+
+	member, ok := parseGroupMemberFromRow(row)
+	if !ok {
+		t.Fatal("parseGroupMemberFromRow() returned false")
+	}
+	if member.DistinctID != "user-1" || member.Email != "" || member.Events != 8 {
+		t.Errorf("parseGroupMemberFromRow() = %+v, want user-1 without email and 8 events", member)
+	}
+
+	if _, ok := parseGroupMemberFromRow([]interface{}{nil, "user-1", "", float64(1), ""}); ok {
+		t.Error("parseGroupMemberFromRow() without a person ID ok = true, want false")
+	}
+}
+
+func TestParsePersonPropertyUpdateFromRow(t *testing.T) {
+	row := []interface{}{"event-1", "subscription_upgraded", "2024-01-15T10:00:00Z", `{"plan":"pro","seats":5}`, "", `["trial_ends"]`}
+
+	update, ok := parsePersonPropertyUpdateFromRow(row)
+	if !ok {
+		t.Fatal("parsePersonPropertyUpdateFromRow() returned false")
+	}
+	if update.Set["plan"] != "pro" || update.Set["seats"] != float64(5) || update.SetOnce != nil {
+		t.Errorf("Set = %v, SetOnce = %v, want plan and seats set", update.Set, update.SetOnce)
+	}
+	if len(update.Unset) != 1 || update.Unset[0] != "trial_ends" {
+		t.Errorf("Unset = %v, want [trial_ends]", update.Unset)
+	}
+
+	if _, ok := parsePersonPropertyUpdateFromRow([]interface{}{"event-2", "$pageview", "2024-01-15T10:00:00Z", "{}", "", ""}); ok {
+		t.Error("parsePersonPropertyUpdateFromRow() without updates ok = true, want false")
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Person represents a PostHog person
@@ -15,6 +18,7 @@ type Person struct {
 	DistinctIDs []string               `json:"distinct_ids"`
 	Properties  map[string]interface{} `json:"properties"`
 	CreatedAt   string                 `json:"created_at"`
+	LastSeenAt  *time.Time             `json:"last_seen_at"`
 	UUID        string                 `json:"uuid"`
 }
 
@@ -57,8 +61,9 @@ func (c *Client) GetPerson(ctx context.Context, distinctID string) (*Person, err
 	return &personsResp.Results[0], nil
 }
 
-// GetPersonEvents fetches recent events for a person using Query API with HogQL
-func (c *Client) GetPersonEvents(ctx context.Context, distinctID string, limit int) ([]Event, error) {
+// GetPersonEvents fetches recent events for a person using Query API with HogQL,
+// skipping the offset most recent ones
+func (c *Client) GetPersonEvents(ctx context.Context, distinctID string, limit, offset int) ([]Event, error) {
 	if limit <= 0 {
 		limit = 10
 	}
@@ -73,10 +78,11 @@ func (c *Client) GetPersonEvents(ctx context.Context, distinctID string, limit i
 			properties,
 			person_id
 		FROM events
-		WHERE distinct_id = '%s'
+		WHERE distinct_id = %s
 		ORDER BY timestamp DESC
 		LIMIT %d
-	`, distinctID, limit)
+		OFFSET %d
+	`, QuoteHogQLString(distinctID), limit, offset)
 
 	result, err := c.ExecuteQuery(ctx, query)
 	if err != nil {
@@ -94,21 +100,77 @@ func (c *Client) GetPersonEvents(ctx context.Context, distinctID string, limit i
 	return events, nil
 }
 
+// PersonPropertyUpdate is an event that set or unset person properties
+type PersonPropertyUpdate struct {
+	UUID      string
+	Event     string
+	Timestamp time.Time
+	Set       map[string]interface{} // $set: properties overwritten
+	SetOnce   map[string]interface{} // $set_once: properties set unless already set
+	Unset     []string               // $unset: properties removed
+}
+
+// GetPersonPropertyUpdates fetches the most recent events (by person UUID)
+// carrying $set, $set_once or $unset payloads, newest first
+func (c *Client) GetPersonPropertyUpdates(ctx context.Context, personUUID string, limit int) ([]PersonPropertyUpdate, error) {
+	if limit <= 0 {
+		limit = 500
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			uuid,
+			event,
+			timestamp,
+			JSONExtractRaw(properties, '$set') AS set,
+			JSONExtractRaw(properties, '$set_once') AS set_once,
+			JSONExtractRaw(properties, '$unset') AS unset
+		FROM events
+		WHERE person_id = %s
+			AND (JSONHas(properties, '$set') OR JSONHas(properties, '$set_once') OR JSONHas(properties, '$unset'))
+		ORDER BY timestamp DESC
+		LIMIT %d
+	`, QuoteHogQLString(personUUID), limit)
+
+	result, err := c.ExecuteQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query person property updates: %w", err)
+	}
+
+	updates := make([]PersonPropertyUpdate, 0, len(result.Results))
+	for _, row := range result.Results {
+		if update, ok := parsePersonPropertyUpdateFromRow(row); ok {
+			updates = append(updates, update)
+		}
+	}
+
+	return updates, nil
+}
+
 // ListPersons fetches a list of persons
 func (c *Client) ListPersons(ctx context.Context, limit int) ([]Person, error) {
+	return c.SearchPersons(ctx, PersonSearch{}, limit)
+}
+
+// SearchPersons fetches the persons matching a search, server-side
+func (c *Client) SearchPersons(ctx context.Context, search PersonSearch, limit int) ([]Person, error) {
 	if limit <= 0 {
 		limit = 50
 	}
 
 	if err := c.ensureProjectInitialized(ctx); err != nil {
-		return nil, fmt.Errorf("ListPersons: %w", err)
+		return nil, fmt.Errorf("SearchPersons: %w", err)
 	}
 
-	path := fmt.Sprintf("%s/persons/?limit=%d", c.getProjectPath(), limit)
+	params, err := search.params(limit)
+	if err != nil {
+		return nil, fmt.Errorf("SearchPersons: %w", err)
+	}
+	path := fmt.Sprintf("%s/persons/?%s", c.getProjectPath(), params.Encode())
 
 	resp, err := c.get(ctx, path)
 	if err != nil {
-		return nil, fmt.Errorf("ListPersons: %w", err)
+		return nil, fmt.Errorf("SearchPersons: %w", err)
 	}
 	defer resp.Body.Close()
 
@@ -124,3 +186,73 @@ func (c *Client) ListPersons(ctx context.Context, limit int) ([]Person, error) {
 
 	return personsResp.Results, nil
 }
+
+// Person property filter operators
+const (
+	PersonPropertyExact     = "exact"
+	PersonPropertyIContains = "icontains"
+)
+
+// PersonPropertyFilter matches persons by the value of one of their properties
+type PersonPropertyFilter struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Operator string `json:"operator"`
+	Type     string `json:"type"`
+}
+
+// PersonSearch narrows the persons listed by SearchPersons
+type PersonSearch struct {
+	Text       string // matched against email, name and distinct IDs
+	Properties []PersonPropertyFilter
+}
+
+// ParsePersonSearch parses a search such as "alice plan=pro email~@acme.com":
+// key=value terms match a property exactly, key~value terms match a property
+// containing the value, and the remaining words are searched in email, name
+// and distinct IDs
+func ParsePersonSearch(input string) PersonSearch {
+	var search PersonSearch
+	var words []string
+	for _, term := range strings.Fields(input) {
+		i := strings.IndexAny(term, "=~")
+		if i <= 0 {
+			words = append(words, term)
+			continue
+		}
+		operator := PersonPropertyExact
+		if term[i] == '~' {
+			operator = PersonPropertyIContains
+		}
+		search.Properties = append(search.Properties, PersonPropertyFilter{
+			Key:      term[:i],
+			Value:    term[i+1:],
+			Operator: operator,
+			Type:     "person",
+		})
+	}
+	search.Text = strings.Join(words, " ")
+	return search
+}
+
+// IsEmpty reports whether the search matches every person
+func (s PersonSearch) IsEmpty() bool {
+	return s.Text == "" && len(s.Properties) == 0
+}
+
+// params encodes the search as query parameters of the persons endpoint
+func (s PersonSearch) params(limit int) (url.Values, error) {
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(limit))
+	if s.Text != "" {
+		params.Set("search", s.Text)
+	}
+	if len(s.Properties) > 0 {
+		properties, err := json.Marshal(s.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode property filters: %w", err)
+		}
+		params.Set("properties", string(properties))
+	}
+	return params, nil
+}
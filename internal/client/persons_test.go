@@ -0,0 +1,56 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePersonSearch(t *testing.T) {
+	search := ParsePersonSearch("  alice plan=pro email~@acme.com  smith =x ")
+
+	if search.Text != "alice smith =x" {
+		t.Errorf("Text = %q, want %q", search.Text, "alice smith =x")
+	}
+	want := []PersonPropertyFilter{
+		{Key: "plan", Value: "pro", Operator: PersonPropertyExact, Type: "person"},
+		{Key: "email", Value: "@acme.com", Operator: PersonPropertyIContains, Type: "person"},
+	}
+	if !reflect.DeepEqual(search.Properties, want) {
+		t.Errorf("Properties = %+v, want %+v", search.Properties, want)
+	}
+
+	if !ParsePersonSearch("   ").IsEmpty() {
+		t.Error("IsEmpty() = false for a blank search, want true")
+	}
+}
+
+func TestPersonSearch_Params(t *testing.T) {
+	tests := []struct {
+		name   string
+		search PersonSearch
+		want   string
+	}{
+		{
+			name:   "no search",
+			search: PersonSearch{},
+			want:   "limit=50",
+		},
+		{
+			name:   "text and properties",
+			search: ParsePersonSearch("ada plan=pro"),
+			want:   "limit=50&properties=%5B%7B%22key%22%3A%22plan%22%2C%22value%22%3A%22pro%22%2C%22operator%22%3A%22exact%22%2C%22type%22%3A%22person%22%7D%5D&search=ada",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := tt.search.params(50)
+			if err != nil {
+				t.Fatalf("params() error = %v", err)
+			}
+			if got := params.Encode(); got != tt.want {
+				t.Errorf("params() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
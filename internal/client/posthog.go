@@ -8,6 +8,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -18,8 +19,22 @@ import (
 
 // Project represents a PostHog team/project
 type Project struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Timezone string `json:"timezone"` // IANA name, e.g. "Europe/Ljubljana"
+}
+
+// Location returns the project's timezone, which insight results are
+// bucketed in, or UTC if unknown
+func (p Project) Location() *time.Location {
+	if p.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
 }
 
 // Client is a PostHog API client wrapper
@@ -232,6 +247,23 @@ func (c *Client) ensureProjectInitialized(ctx context.Context) error {
 	return nil
 }
 
+// nextPagePath converts the absolute "next" URL of a paginated response
+// into a request path, or returns "" when there are no more pages
+func nextPagePath(next *string) string {
+	if next == nil || *next == "" {
+		return ""
+	}
+
+	u, err := url.Parse(*next)
+	if err != nil {
+		return ""
+	}
+	if u.RawQuery == "" {
+		return u.Path
+	}
+	return u.Path + "?" + u.RawQuery
+}
+
 // getProjectPath returns the project API path prefix
 func (c *Client) getProjectPath() string {
 	if c.projectID > 0 {
@@ -251,8 +283,9 @@ func (c *Client) FetchProjects(ctx context.Context) ([]Project, error) {
 	var userInfo struct {
 		Organization struct {
 			Teams []struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
+				ID       int    `json:"id"`
+				Name     string `json:"name"`
+				Timezone string `json:"timezone"`
 			} `json:"teams"`
 		} `json:"organization"`
 	}
@@ -263,7 +296,7 @@ func (c *Client) FetchProjects(ctx context.Context) ([]Project, error) {
 
 	projects := make([]Project, len(userInfo.Organization.Teams))
 	for i, team := range userInfo.Organization.Teams {
-		projects[i] = Project{ID: team.ID, Name: team.Name}
+		projects[i] = Project{ID: team.ID, Name: team.Name, Timezone: team.Timezone}
 	}
 
 	c.projects = projects
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// SessionRecording is the summary of a session replay
+type SessionRecording struct {
+	ID                string    `json:"id"` // the recorded $session_id
+	DistinctID        string    `json:"distinct_id"`
+	StartTime         time.Time `json:"start_time"`
+	EndTime           time.Time `json:"end_time"`
+	RecordingDuration float64   `json:"recording_duration"` // seconds
+	ActiveSeconds     float64   `json:"active_seconds"`
+	ClickCount        int       `json:"click_count"`
+	KeypressCount     int       `json:"keypress_count"`
+	ConsoleErrorCount int       `json:"console_error_count"`
+	StartURL          string    `json:"start_url"`
+	Viewed            bool      `json:"viewed"`
+	Ongoing           bool      `json:"ongoing"`
+}
+
+// Duration returns the length of the recording
+func (r SessionRecording) Duration() time.Duration {
+	return time.Duration(r.RecordingDuration * float64(time.Second))
+}
+
+// ActiveDuration returns how long the user was active during the recording
+func (r SessionRecording) ActiveDuration() time.Duration {
+	return time.Duration(r.ActiveSeconds * float64(time.Second))
+}
+
+// SessionRecordingsResponse represents the API response for session recordings list
+type SessionRecordingsResponse struct {
+	Results []SessionRecording `json:"results"`
+	HasNext bool               `json:"has_next"`
+}
+
+// RecordingsLookbackDays is how far back recordings are listed, matching the
+// longest replay retention; the API would otherwise list only recent days
+const RecordingsLookbackDays = 90
+
+// ListPersonRecordings fetches a person's (by UUID) most recent session
+// recordings of the last RecordingsLookbackDays days
+func (c *Client) ListPersonRecordings(ctx context.Context, personUUID string, limit int) ([]SessionRecording, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("ListPersonRecordings: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/session_recordings/?person_uuid=%s&limit=%d&date_from=-%dd",
+		c.getProjectPath(), url.QueryEscape(personUUID), limit, RecordingsLookbackDays)
+
+	resp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("ListPersonRecordings: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var recordingsResp SessionRecordingsResponse
+	if err := json.Unmarshal(body, &recordingsResp); err != nil {
+		return nil, fmt.Errorf("failed to parse session recordings response: %w", err)
+	}
+
+	return recordingsResp.Results, nil
+}
+
+// ReplayURL returns the URL of a session's replay in the PostHog app
+func (c *Client) ReplayURL(sessionID string) string {
+	return fmt.Sprintf("%s/project/%d/replay/%s", c.instanceURL, c.projectID, url.PathEscape(sessionID))
+}
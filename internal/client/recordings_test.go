@@ -0,0 +1,46 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestSessionRecordingDecode(t *testing.T) {
+	body := `{
+		"id": "0190a1b2-c3d4",
+		"distinct_id": "user-1",
+		"start_time": "2024-03-05T14:30:00Z",
+		"end_time": "2024-03-05T14:42:30Z",
+		"recording_duration": 750,
+		"active_seconds": 90.5,
+		"click_count": 12,
+		"console_error_count": 2,
+		"start_url": "https://example.com/pricing",
+		"viewed": false,
+		"ongoing": false
+	}`
+
+	var recording SessionRecording
+	if err := json.Unmarshal([]byte(body), &recording); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if got, want := recording.Duration(), 750*time.Second; got != want {
+		t.Errorf("Duration() = %v, want %v", got, want)
+	}
+	if got, want := recording.ActiveDuration(), 90500*time.Millisecond; got != want {
+		t.Errorf("ActiveDuration() = %v, want %v", got, want)
+	}
+	if recording.ClickCount != 12 || recording.ConsoleErrorCount != 2 {
+		t.Errorf("counts = %d clicks, %d errors, want 12, 2", recording.ClickCount, recording.ConsoleErrorCount)
+	}
+}
+
+func TestReplayURL(t *testing.T) {
+	c := &Client{instanceURL: "https://us.posthog.com", projectID: 42}
+
+	if got, want := c.ReplayURL("0190a1b2-c3d4"), "https://us.posthog.com/project/42/replay/0190a1b2-c3d4"; got != want {
+		t.Errorf("ReplayURL() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// SurveyStatus is the lifecycle stage of a survey
+type SurveyStatus string
+
+const (
+	SurveyDraft    SurveyStatus = "draft"
+	SurveyRunning  SurveyStatus = "running"
+	SurveyComplete SurveyStatus = "complete"
+	SurveyArchived SurveyStatus = "archived"
+)
+
+// Survey question types
+const (
+	SurveyQuestionOpen           = "open"
+	SurveyQuestionLink           = "link"
+	SurveyQuestionRating         = "rating"
+	SurveyQuestionSingleChoice   = "single_choice"
+	SurveyQuestionMultipleChoice = "multiple_choice"
+)
+
+// surveySentEvent is the event captured when a survey is answered
+const surveySentEvent = "survey sent"
+
+// SurveyQuestion is one question of a survey
+type SurveyQuestion struct {
+	ID              string   `json:"id"`
+	Type            string   `json:"type"`
+	Question        string   `json:"question"`
+	Description     string   `json:"description"`
+	Choices         []string `json:"choices"`
+	Scale           int      `json:"scale"`   // rating questions: 3, 5, 7 or 10
+	Display         string   `json:"display"` // rating questions: "number" or "emoji"
+	LowerBoundLabel string   `json:"lowerBoundLabel"`
+	UpperBoundLabel string   `json:"upperBoundLabel"`
+}
+
+// SurveyConditions restrict where and when a survey is shown
+type SurveyConditions struct {
+	URL                        string `json:"url"`
+	URLMatchType               string `json:"urlMatchType"`
+	Selector                   string `json:"selector"`
+	SeenSurveyWaitPeriodInDays *int   `json:"seenSurveyWaitPeriodInDays"`
+	Events                     *struct {
+		Values []struct {
+			Name string `json:"name"`
+		} `json:"values"`
+	} `json:"events"`
+}
+
+// SurveyFlag is a feature flag a survey is linked to or targeted by
+type SurveyFlag struct {
+	ID  int    `json:"id"`
+	Key string `json:"key"`
+}
+
+// Survey represents a PostHog survey
+type Survey struct {
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description"`
+	Type           string            `json:"type"` // "popover", "widget" or "api"
+	Questions      []SurveyQuestion  `json:"questions"`
+	Conditions     *SurveyConditions `json:"conditions"`
+	LinkedFlag     *SurveyFlag       `json:"linked_flag"`
+	TargetingFlag  *SurveyFlag       `json:"targeting_flag"`
+	ResponsesLimit *int              `json:"responses_limit"`
+	StartDate      *time.Time        `json:"start_date"`
+	EndDate        *time.Time        `json:"end_date"`
+	CreatedAt      string            `json:"created_at"`
+	Archived       bool              `json:"archived"`
+}
+
+// Status derives the survey's lifecycle stage from its dates
+func (s Survey) Status() SurveyStatus {
+	switch {
+	case s.Archived:
+		return SurveyArchived
+	case s.StartDate == nil:
+		return SurveyDraft
+	case s.EndDate == nil:
+		return SurveyRunning
+	default:
+		return SurveyComplete
+	}
+}
+
+// SurveysResponse represents the API response for surveys list
+type SurveysResponse struct {
+	Next     *string  `json:"next"`
+	Previous *string  `json:"previous"`
+	Results  []Survey `json:"results"`
+}
+
+// ListSurveys fetches all surveys, following pagination
+func (c *Client) ListSurveys(ctx context.Context) ([]Survey, error) {
+	if err := c.ensureProjectInitialized(ctx); err != nil {
+		return nil, fmt.Errorf("ListSurveys: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/surveys/", c.getProjectPath())
+
+	var surveys []Survey
+	for path != "" {
+		resp, err := c.get(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("ListSurveys: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var surveysResp SurveysResponse
+		if err := json.Unmarshal(body, &surveysResp); err != nil {
+			return nil, fmt.Errorf("failed to parse surveys response: %w", err)
+		}
+
+		surveys = append(surveys, surveysResp.Results...)
+		path = nextPagePath(surveysResp.Next)
+	}
+
+	return surveys, nil
+}
+
+// CountSurveyResponses counts the "survey sent" events of every survey,
+// keyed by survey ID
+func (c *Client) CountSurveyResponses(ctx context.Context) (map[string]int, error) {
+	query := fmt.Sprintf(`
+		SELECT %s AS survey_id, count() AS responses
+		FROM events
+		WHERE event = %s
+		GROUP BY survey_id
+		LIMIT 10000
+	`, HogQLProperty("$survey_id"), QuoteHogQLString(surveySentEvent))
+
+	result, err := c.ExecuteQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count survey responses: %w", err)
+	}
+
+	counts := make(map[string]int, len(result.Results))
+	for _, row := range result.Results {
+		if len(row) < 2 {
+			continue
+		}
+		id, _ := row[0].(string)
+		// Counts are decoded from JSON as float64
+		count, _ := row[1].(float64)
+		if id != "" {
+			counts[id] = int(count)
+		}
+	}
+
+	return counts, nil
+}
+
+// ListSurveyResponses fetches the most recent "survey sent" events of a survey
+func (c *Client) ListSurveyResponses(ctx context.Context, surveyID string, limit int) ([]Event, error) {
+	condition := fmt.Sprintf("event = %s AND %s = %s",
+		QuoteHogQLString(surveySentEvent), HogQLProperty("$survey_id"), QuoteHogQLString(surveyID))
+	return c.listEvents(ctx, "WHERE "+condition, limit)
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TrendInterval is the bucket size of a trend query
+type TrendInterval string
+
+const (
+	TrendHourly TrendInterval = "hour"
+	TrendDaily  TrendInterval = "day"
+)
+
+// bucketFunction returns the HogQL function truncating timestamps to the interval
+func (i TrendInterval) bucketFunction() string {
+	if i == TrendDaily {
+		return "toStartOfDay"
+	}
+	return "toStartOfHour"
+}
+
+// Step returns the duration of one bucket
+func (i TrendInterval) Step() time.Duration {
+	if i == TrendDaily {
+		return 24 * time.Hour
+	}
+	return time.Hour
+}
+
+// TrendPoint is the event count of one bucket (and breakdown value, if any)
+type TrendPoint struct {
+	Bucket    time.Time
+	Breakdown string
+	Count     float64
+}
+
+// GetEventTrend counts an event per bucket over the given window, optionally
+// broken down by an event property. Empty buckets are not returned.
+func (c *Client) GetEventTrend(ctx context.Context, event string, window time.Duration, interval TrendInterval, breakdownProperty string) ([]TrendPoint, error) {
+	bucket := fmt.Sprintf("%s(timestamp)", interval.bucketFunction())
+
+	breakdownSelect, breakdownGroup := "", ""
+	if breakdownProperty != "" {
+		breakdownSelect = fmt.Sprintf(", toString(%s) AS breakdown", HogQLProperty(breakdownProperty))
+		breakdownGroup = ", breakdown"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			%s AS bucket,
+			count() AS count%s
+		FROM events
+		WHERE event = %s
+			AND timestamp > now() - INTERVAL %d HOUR
+		GROUP BY bucket%s
+		ORDER BY bucket
+		LIMIT 10000
+	`, bucket, breakdownSelect, QuoteHogQLString(event), int(window.Hours()), breakdownGroup)
+
+	result, err := c.ExecuteQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query event trend: %w", err)
+	}
+
+	points := make([]TrendPoint, 0, len(result.Results))
+	for _, row := range result.Results {
+		if point, ok := parseTrendPointFromRow(row); ok {
+			points = append(points, point)
+		}
+	}
+
+	return points, nil
+}
@@ -12,8 +12,12 @@ import (
 type Config struct {
 	ProjectAPIKey string `yaml:"project_api_key"`
 	InstanceURL   string `yaml:"instance_url"`
-	PollInterval  int    `yaml:"poll_interval"` // seconds
-	Debug         bool   `yaml:"-"`              // runtime only, not saved to file
+	PollInterval  int    `yaml:"poll_interval"`       // seconds
+	RepoPath      string `yaml:"repo_path,omitempty"` // local source tree scanned for flag references
+	Debug         bool   `yaml:"-"`                   // runtime only, not saved to file
+
+	// PersonColumns maps project IDs to the person columns shown in the TUI's Persons list
+	PersonColumns map[int][]string `yaml:"person_columns,omitempty"`
 }
 
 const (
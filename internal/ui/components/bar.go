@@ -0,0 +1,25 @@
+package components
+
+import (
+	"math"
+	"strings"
+
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+)
+
+// RenderBar renders a horizontal bar filled to fraction (0..1) of width cells
+func RenderBar(fraction float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if math.IsNaN(fraction) || fraction < 0 {
+		fraction = 0
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+
+	filled := int(math.Round(fraction * float64(width)))
+	return styles.HighlightTextStyle.Render(strings.Repeat("█", filled)) +
+		styles.DimTextStyle.Render(strings.Repeat("░", width-filled))
+}
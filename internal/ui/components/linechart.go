@@ -0,0 +1,199 @@
+package components
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// brailleDots maps a dot's [row][column] within a cell to its braille bit
+var brailleDots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// seriesColors are the colors of successive series in line charts
+var seriesColors = []lipgloss.Color{
+	styles.ColorPrimaryBlueLight,
+	styles.ColorAccentOrange,
+	styles.ColorSuccess,
+	styles.ColorSecondaryPurple,
+	styles.ColorInfo,
+	styles.ColorError,
+}
+
+// SeriesStyle returns the style of the i-th series of a line chart, for legends
+func SeriesStyle(i int) lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(seriesColors[i%len(seriesColors)])
+}
+
+// ChartColumn returns the cell column at which point i of n is drawn in a
+// chart width cells wide, e.g. to align axis labels and markers
+func ChartColumn(i, n, width int) int {
+	return ChartPosition(float64(i), n, width)
+}
+
+// ChartPosition is ChartColumn for a fractional point index, e.g. for a
+// marker between two buckets
+func ChartPosition(x float64, n, width int) int {
+	if n < 2 || width < 1 {
+		return 0
+	}
+	return int(math.Round(x*float64(width*2-1)/float64(n-1))) / 2
+}
+
+// LineChart renders series as braille line charts of width x height cells
+// sharing a y-axis from zero to the largest value. Each line is prefixed
+// with the y-axis, labelled with the maximum at the top and zero at the bottom.
+func LineChart(series [][]float64, width, height int) []string {
+	if width < 1 || height < 1 {
+		return nil
+	}
+
+	max := 0.0
+	for _, values := range series {
+		for _, v := range values {
+			max = math.Max(max, v)
+		}
+	}
+
+	pixelWidth, pixelHeight := width*2, height*4
+	dots := make([][]rune, height)
+	colors := make([][]int, height)
+	for row := range dots {
+		dots[row] = make([]rune, width)
+		colors[row] = make([]int, width)
+		for col := range colors[row] {
+			colors[row][col] = -1
+		}
+	}
+
+	set := func(x, y, seriesIndex int) {
+		if x < 0 || y < 0 || x >= pixelWidth || y >= pixelHeight {
+			return
+		}
+		row, col := y/4, x/2
+		dots[row][col] |= brailleDots[y%4][x%2]
+		if colors[row][col] < 0 {
+			colors[row][col] = seriesIndex
+		}
+	}
+
+	for s, values := range series {
+		n := len(values)
+		var prevX, prevY int
+		for i, v := range values {
+			x := 0
+			if n > 1 {
+				x = int(math.Round(float64(i) * float64(pixelWidth-1) / float64(n-1)))
+			}
+			y := pixelHeight - 1
+			if max > 0 {
+				y = pixelHeight - 1 - int(math.Round(v/max*float64(pixelHeight-1)))
+			}
+
+			if i == 0 {
+				set(x, y, s)
+			} else {
+				drawLine(prevX, prevY, x, y, func(x, y int) { set(x, y, s) })
+			}
+			prevX, prevY = x, y
+		}
+	}
+
+	// Y-axis labels
+	top, bottom := FormatCompactNumber(max), "0"
+	labelWidth := styles.Max(len(top), len(bottom))
+
+	lines := make([]string, height)
+	for row := range dots {
+		label := ""
+		switch row {
+		case 0:
+			label = top
+		case height - 1:
+			label = bottom
+		}
+
+		var sb strings.Builder
+		sb.WriteString(styles.DimTextStyle.Render(fmt.Sprintf("%*s ┤", labelWidth, label)))
+		for col, cell := range dots[row] {
+			if cell == 0 {
+				sb.WriteRune(' ')
+				continue
+			}
+			sb.WriteString(SeriesStyle(colors[row][col]).Render(string(0x2800 + cell)))
+		}
+		lines[row] = sb.String()
+	}
+
+	return lines
+}
+
+// ChartAxisWidth returns the width of the y-axis LineChart prefixes to each
+// line for the given series, so callers can align x-axis labels
+func ChartAxisWidth(series [][]float64) int {
+	max := 0.0
+	for _, values := range series {
+		for _, v := range values {
+			max = math.Max(max, v)
+		}
+	}
+	return styles.Max(len(FormatCompactNumber(max)), 1) + 2
+}
+
+// drawLine calls set for each pixel on the line between two points (Bresenham)
+func drawLine(x0, y0, x1, y1 int, set func(x, y int)) {
+	dx := abs(x1 - x0)
+	dy := -abs(y1 - y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	err := dx + dy
+	for {
+		set(x0, y0)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// FormatCompactNumber renders a number with a k/M suffix, e.g. "950", "1.2k", "3.4M"
+func FormatCompactNumber(v float64) string {
+	switch {
+	case math.Abs(v) >= 1e6:
+		return strings.TrimSuffix(fmt.Sprintf("%.1f", v/1e6), ".0") + "M"
+	case math.Abs(v) >= 1e3:
+		return strings.TrimSuffix(fmt.Sprintf("%.1f", v/1e3), ".0") + "k"
+	case v == math.Trunc(v):
+		return fmt.Sprintf("%d", int64(v))
+	default:
+		return fmt.Sprintf("%.1f", v)
+	}
+}
@@ -0,0 +1,61 @@
+package components
+
+import (
+	"math"
+	"strings"
+
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+)
+
+// sparkLevels are the block characters used for sparklines, lowest first
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders values as a single line of block characters scaled
+// between zero and the maximum value. Values are averaged into buckets
+// if there are more of them than width cells.
+func Sparkline(values []float64, width int) string {
+	values = Resample(values, width)
+	if len(values) == 0 {
+		return ""
+	}
+
+	max := 0.0
+	for _, v := range values {
+		max = math.Max(max, v)
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		level := 0
+		if max > 0 && v > 0 {
+			level = int(math.Round(v / max * float64(len(sparkLevels)-1)))
+		}
+		sb.WriteRune(sparkLevels[level])
+	}
+
+	return styles.HighlightTextStyle.Render(sb.String())
+}
+
+// Resample averages values into at most width buckets of roughly equal size
+func Resample(values []float64, width int) []float64 {
+	if width <= 0 {
+		return nil
+	}
+	if len(values) <= width {
+		return values
+	}
+
+	resampled := make([]float64, width)
+	for i := range resampled {
+		start := i * len(values) / width
+		end := (i + 1) * len(values) / width
+
+		sum := 0.0
+		for _, v := range values[start:end] {
+			sum += v
+		}
+		resampled[i] = sum / float64(end-start)
+	}
+
+	return resampled
+}
@@ -0,0 +1,253 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxActionNameLen is the truncation length of action names in the list
+const maxActionNameLen = 35
+
+// actionCountWindows are the match count windows (in days) cycled with 'd'
+var actionCountWindows = []int{7, 30, 90}
+
+// actionCount holds the number of events matching an action in a window
+type actionCount struct {
+	count   int
+	loading bool
+	err     error
+}
+
+// actionsMsg is sent when the actions list has been fetched
+type actionsMsg []client.Action
+
+// actionCountMsg is sent when an action's matching events have been counted
+type actionCountMsg struct {
+	key   string
+	count int
+	err   error
+}
+
+func fetchActions(c client.PostHogClient) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		actions, err := c.ListActions(ctx)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		return actionsMsg(actions)
+	}
+}
+
+// fetchActionCount counts the events matching a compiled action condition
+func fetchActionCount(c client.PostHogClient, key, condition string, days int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		count, err := c.CountEventsMatching(ctx, condition, time.Duration(days)*24*time.Hour)
+		return actionCountMsg{key: key, count: count, err: err}
+	}
+}
+
+// actionCountKey identifies an action's match count in a window
+func actionCountKey(actionID, days int) string {
+	return fmt.Sprintf("%d:%d", actionID, days)
+}
+
+// loadActionCount returns a command counting the inspected action's matching
+// events in the current window, or nil if already loaded
+func (m *Model) loadActionCount(action client.Action) tea.Cmd {
+	key := actionCountKey(action.ID, m.actionDays)
+	if _, loaded := m.actionCounts[key]; loaded {
+		return nil
+	}
+
+	condition, _, err := utils.CompileActionSteps(action.Steps)
+	if err != nil {
+		m.actionCounts[key] = actionCount{err: err}
+		return nil
+	}
+	m.actionCounts[key] = actionCount{loading: true}
+	cmds := []tea.Cmd{fetchActionCount(m.client, key, condition, m.actionDays)}
+
+	// Resolve cohort IDs in step filters to names
+	if m.cohortNames == nil && !m.cohortNamesLoading && len(actionCohortIDs(action)) > 0 {
+		m.cohortNamesLoading = true
+		cmds = append(cmds, fetchCohortNames(m.client))
+	}
+	return tea.Batch(cmds...)
+}
+
+// actionCohortIDs returns the cohort IDs referenced by an action's steps
+func actionCohortIDs(action client.Action) []int {
+	var filters []interface{}
+	for _, step := range action.Steps {
+		for _, filter := range step.Properties {
+			filters = append(filters, filter)
+		}
+	}
+	return utils.CohortIDsInFilters(filters)
+}
+
+// cycleActionDays switches to the next match count window
+func (m *Model) cycleActionDays() {
+	next := 0
+	for i, days := range actionCountWindows {
+		if days == m.actionDays {
+			next = (i + 1) % len(actionCountWindows)
+			break
+		}
+	}
+	m.actionDays = actionCountWindows[next]
+}
+
+// tailSelectedAction switches to the live Events list, filtered to events
+// matching the inspected action
+func (m *Model) tailSelectedAction() tea.Cmd {
+	action, ok := m.inspectorData.(client.Action)
+	if !ok {
+		return nil
+	}
+
+	condition, skipped, err := utils.CompileActionSteps(action.Steps)
+	if err != nil {
+		m.showClipboardFeedback("Can't tail: " + err.Error())
+		return nil
+	}
+	if len(skipped) > 0 {
+		m.showClipboardFeedback(fmt.Sprintf("Ignoring %d unsupported criteria", len(skipped)))
+	}
+	return m.tailEvents(action.Name, condition)
+}
+
+// ActionListItem wraps a client.Action for list display
+type ActionListItem struct {
+	Action client.Action
+}
+
+func (a ActionListItem) RenderLine(width int, selected bool) string {
+	name := a.Action.Name
+	if len(name) > maxActionNameLen {
+		name = styles.TruncateString(name, maxActionNameLen)
+	}
+
+	steps := fmt.Sprintf("%d steps", len(a.Action.Steps))
+	if len(a.Action.Steps) == 1 {
+		steps = "1 step"
+	}
+
+	line := fmt.Sprintf("%s %s", name, styles.DimTextStyle.Render(steps))
+	if len(a.Action.Tags) > 0 {
+		line += " " + styles.DimTextStyle.Render(strings.Join(a.Action.Tags, ","))
+	}
+
+	if selected {
+		line = styles.SelectedListItemStyle.Render("▶ " + line)
+	} else {
+		line = styles.ListItemStyle.Render("  " + line)
+	}
+
+	return line
+}
+
+func (a ActionListItem) GetID() string {
+	return fmt.Sprintf("%d", a.Action.ID)
+}
+
+func (a ActionListItem) GetInspectorData() interface{} {
+	return a.Action
+}
+
+func (a ActionListItem) GetDistinctID() string {
+	return "" // Actions don't have distinct IDs
+}
+
+func (a ActionListItem) GetSearchableText() string {
+	return a.Action.Name + " " + strings.Join(a.Action.Tags, " ") + " " + a.Action.Description
+}
+
+// renderActionInspectorScrollable renders an action's steps as readable
+// conditions along with how many events matched it recently
+func (m Model) renderActionInspectorScrollable(width, height int) string {
+	action, ok := m.inspectorData.(client.Action)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid action data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Action: ")+action.Name)
+	lines = append(lines, styles.JSONKeyStyle.Render("ID: ")+fmt.Sprintf("%d", action.ID))
+	if action.CreatedBy != nil {
+		lines = append(lines, styles.JSONKeyStyle.Render("Created by: ")+ownerLabel(client.DefinitionOwner{
+			FirstName: action.CreatedBy.FirstName,
+			Email:     action.CreatedBy.Email,
+		}))
+	}
+	if action.CreatedAt != "" {
+		lines = append(lines, styles.JSONKeyStyle.Render("Created: ")+action.CreatedAt)
+	}
+	if len(action.Tags) > 0 {
+		lines = append(lines, styles.JSONKeyStyle.Render("Tags: ")+strings.Join(action.Tags, ", "))
+	}
+
+	label := styles.JSONKeyStyle.Render(fmt.Sprintf("Matching events (%dd): ", m.actionDays))
+	count, loaded := m.actionCounts[actionCountKey(action.ID, m.actionDays)]
+	switch {
+	case !loaded || count.loading:
+		lines = append(lines, label+m.spinner.View())
+	case count.err != nil:
+		lines = append(lines, label+styles.ErrorTextStyle.Render(count.err.Error()))
+	default:
+		lines = append(lines, label+fmt.Sprintf("%d", count.count))
+	}
+
+	if action.Description != "" {
+		lines = append(lines, "")
+		lines = append(lines, action.Description)
+	}
+
+	// Steps are alternatives: an event matches the action if it matches any step
+	lines = append(lines, "")
+	if len(action.Steps) == 0 {
+		lines = append(lines, styles.DimTextStyle.Render("No steps"))
+	}
+	for i, step := range action.Steps {
+		if i > 0 {
+			lines = append(lines, styles.DimTextStyle.Render("  or"))
+		}
+		lines = append(lines, styles.HighlightTextStyle.Render(fmt.Sprintf("Step %d", i+1)))
+		for _, condition := range utils.DescribeActionStep(step, m.cohortNames) {
+			lines = append(lines, "  • "+condition)
+		}
+	}
+
+	if _, skipped, err := utils.CompileActionSteps(action.Steps); err == nil && len(skipped) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, styles.WarningTextStyle.Render("Not matched when counting or tailing:"))
+		for _, criterion := range skipped {
+			lines = append(lines, styles.DimTextStyle.Render("  "+criterion))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.DimTextStyle.Render("Press L to tail live matching events, d to change the window"))
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
@@ -0,0 +1,284 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/components"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxAnnotationContentLen is the truncation length of annotations in the list
+const maxAnnotationContentLen = 40
+
+// annotationsMsg is sent when the annotations list has been fetched
+type annotationsMsg []client.Annotation
+
+// chartAnnotationsMsg is sent when annotations have been fetched in the
+// background to mark them on charts
+type chartAnnotationsMsg struct {
+	annotations []client.Annotation
+	err         error
+}
+
+// annotationCreatedMsg is sent when an annotation has been created
+type annotationCreatedMsg struct {
+	annotation *client.Annotation
+	err        error
+}
+
+func fetchAnnotations(c client.PostHogClient) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		annotations, err := c.ListAnnotations(ctx)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		return annotationsMsg(annotations)
+	}
+}
+
+// fetchChartAnnotations fetches annotations in the background for chart markers
+func fetchChartAnnotations(c client.PostHogClient) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		annotations, err := c.ListAnnotations(ctx)
+		return chartAnnotationsMsg{annotations: annotations, err: err}
+	}
+}
+
+func createAnnotation(c client.PostHogClient, content string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		annotation, err := c.CreateAnnotation(ctx, content, time.Now())
+		return annotationCreatedMsg{annotation: annotation, err: err}
+	}
+}
+
+// loadChartAnnotations returns a command fetching annotations for chart
+// markers, or nil if they are already loaded
+func (m *Model) loadChartAnnotations() tea.Cmd {
+	if m.annotations != nil || m.annotationsLoading {
+		return nil
+	}
+	m.annotationsLoading = true
+	return fetchChartAnnotations(m.client)
+}
+
+// setAnnotations stores annotations, newest first
+func (m *Model) setAnnotations(annotations []client.Annotation) {
+	sort.SliceStable(annotations, func(i, j int) bool {
+		return annotations[i].DateMarker.After(annotations[j].DateMarker)
+	})
+	if annotations == nil {
+		annotations = []client.Annotation{}
+	}
+	m.annotations = annotations
+}
+
+// startAnnotation prompts for the content of a new annotation placed now
+func (m *Model) startAnnotation() {
+	m.openPrompt("Annotate now", "e.g. Deployed v2.3", func(m *Model, value string) tea.Cmd {
+		return createAnnotation(m.client, value)
+	})
+}
+
+// applyAnnotationCreated adds a new annotation to the list and chart markers
+func (m *Model) applyAnnotationCreated(msg annotationCreatedMsg) {
+	if msg.err != nil {
+		m.showClipboardFeedback(fmt.Sprintf("Annotation failed: %v", msg.err))
+		return
+	}
+
+	if m.annotations != nil {
+		m.setAnnotations(append(m.annotations, *msg.annotation))
+	}
+	if m.selectedResource == ResourceAnnotations {
+		m.setAnnotationListItems()
+		m.filteredItems = nil
+		m.clampListCursor()
+		m.updateInspectorFromCursor()
+	}
+	m.showClipboardFeedback("Annotation created")
+}
+
+// setAnnotationListItems lists the stored annotations
+func (m *Model) setAnnotationListItems() {
+	m.listItems = make([]ListItem, len(m.annotations))
+	for i, annotation := range m.annotations {
+		m.listItems[i] = AnnotationListItem{Annotation: annotation}
+	}
+}
+
+// AnnotationListItem wraps a client.Annotation for list display
+type AnnotationListItem struct {
+	Annotation client.Annotation
+}
+
+func (a AnnotationListItem) RenderLine(width int, selected bool) string {
+	content := a.Annotation.Content
+	if len(content) > maxAnnotationContentLen {
+		content = styles.TruncateString(content, maxAnnotationContentLen)
+	}
+
+	date := a.Annotation.DateMarker.Local().Format("2006-01-02 15:04")
+	line := fmt.Sprintf("%s %s %s %s",
+		styles.DimTextStyle.Render(date),
+		styles.HighlightTextStyle.Render(fmt.Sprintf("%-7s", styles.TruncateString(a.Annotation.ScopeLabel(), 7))),
+		styles.DimTextStyle.Render(styles.TruncateString(a.Annotation.Creator(), 12)),
+		content)
+
+	if selected {
+		line = styles.SelectedListItemStyle.Render("▶ " + line)
+	} else {
+		line = styles.ListItemStyle.Render("  " + line)
+	}
+
+	return line
+}
+
+func (a AnnotationListItem) GetID() string {
+	return fmt.Sprintf("%d", a.Annotation.ID)
+}
+
+func (a AnnotationListItem) GetInspectorData() interface{} {
+	return a.Annotation
+}
+
+func (a AnnotationListItem) GetDistinctID() string {
+	return "" // Annotations don't have distinct IDs
+}
+
+func (a AnnotationListItem) GetSearchableText() string {
+	return a.Annotation.Content + " " + a.Annotation.Creator() + " " + a.Annotation.ScopeLabel()
+}
+
+// renderAnnotationInspectorScrollable renders an annotation
+func (m Model) renderAnnotationInspectorScrollable(width, height int) string {
+	annotation, ok := m.inspectorData.(client.Annotation)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid annotation data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Date: ")+client.FormatEventTime(annotation.DateMarker.Local())+
+		styles.DimTextStyle.Render(" ("+formatAnnotationAge(annotation.DateMarker)+")"))
+	lines = append(lines, styles.JSONKeyStyle.Render("Scope: ")+annotation.ScopeLabel())
+	lines = append(lines, styles.JSONKeyStyle.Render("Created by: ")+annotation.Creator())
+	if annotation.CreatedAt != "" {
+		lines = append(lines, styles.JSONKeyStyle.Render("Created: ")+annotation.CreatedAt)
+	}
+	lines = append(lines, "")
+	lines = append(lines, annotation.Content)
+
+	lines = append(lines, "")
+	lines = append(lines, styles.DimTextStyle.Render("Press a to annotate now"))
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// formatAnnotationAge describes how far an annotation is from now
+func formatAnnotationAge(t time.Time) string {
+	if d := time.Until(t); d > 0 {
+		return "in " + utils.FormatDuration(d)
+	}
+	return utils.FormatDuration(time.Since(t)) + " ago"
+}
+
+// chartAnnotations returns the annotations to mark on charts: those of the
+// project or organization, not those scoped to another insight or dashboard
+func (m Model) chartAnnotations() []client.Annotation {
+	var annotations []client.Annotation
+	for _, annotation := range m.annotations {
+		if annotation.Scope == client.AnnotationScopeProject || annotation.Scope == client.AnnotationScopeOrganization {
+			annotations = append(annotations, annotation)
+		}
+	}
+	return annotations
+}
+
+// renderAnnotationMarkers renders a row of numbered markers for the
+// annotations falling within a chart's buckets, aligned like renderTimeAxis,
+// and a legend line per marker. Annotations must be sorted newest first.
+func renderAnnotationMarkers(annotations []client.Annotation, buckets []time.Time, interval client.TrendInterval, plotWidth int) (string, []string) {
+	return placeAnnotationMarkers(annotations, buckets, interval.Step(), plotWidth, func(x float64) int {
+		return components.ChartPosition(x, len(buckets), plotWidth)
+	})
+}
+
+// renderSparklineMarkers renders annotation markers aligned with a sparkline
+// of the buckets' values, which has a cell per bucket or per group of
+// buckets if resampled to fit width
+func renderSparklineMarkers(annotations []client.Annotation, buckets []time.Time, width int) (string, []string) {
+	if len(buckets) < 2 {
+		return "", nil
+	}
+	cells := styles.Min(len(buckets), width)
+	return placeAnnotationMarkers(annotations, buckets, buckets[1].Sub(buckets[0]), cells, func(x float64) int {
+		// The last cell whose resampled group starts at or before the bucket
+		return ((int(x)+1)*cells+len(buckets)-1)/len(buckets) - 1
+	})
+}
+
+// placeAnnotationMarkers renders the markers of the annotations within
+// buckets of step, at the columns of their fractional bucket index
+func placeAnnotationMarkers(annotations []client.Annotation, buckets []time.Time, step time.Duration, plotWidth int, column func(x float64) int) (string, []string) {
+	if len(buckets) == 0 || plotWidth < 1 {
+		return "", nil
+	}
+
+	first := buckets[0]
+	end := buckets[len(buckets)-1].Add(step)
+
+	// Oldest first, so markers are numbered left to right
+	var shown []client.Annotation
+	for i := len(annotations) - 1; i >= 0; i-- {
+		date := annotations[i].DateMarker
+		if !date.Before(first) && date.Before(end) {
+			shown = append(shown, annotations[i])
+		}
+	}
+	if len(shown) == 0 {
+		return "", nil
+	}
+
+	axis := []rune(strings.Repeat(" ", plotWidth))
+	var legend []string
+	for i, annotation := range shown {
+		marker := "+"
+		if i < 9 {
+			marker = fmt.Sprintf("%d", i+1)
+		}
+
+		// Position within the bucket the annotation falls in
+		x := float64(annotation.DateMarker.Sub(first)) / float64(step)
+		col := styles.Max(0, styles.Min(column(x), plotWidth-1))
+		axis[col] = []rune(marker)[0]
+
+		legend = append(legend, fmt.Sprintf("%s %s %s",
+			styles.WarningTextStyle.Render(marker),
+			styles.DimTextStyle.Render(annotation.DateMarker.Local().Format("Jan 2 15:04")),
+			annotation.Content))
+	}
+
+	return styles.WarningTextStyle.Render(string(axis)), legend
+}
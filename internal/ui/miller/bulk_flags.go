@@ -0,0 +1,262 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// bulkOperation is a bulk flag toggle shown as an overlay: first as a
+// preview awaiting confirmation, then with per-flag results
+type bulkOperation struct {
+	toggles  []utils.FlagToggle
+	results  []utils.FlagToggleResult // nil until applied
+	applying bool
+	rollback bool // this batch restores a previous one
+}
+
+// bulkResultMsg is sent when a bulk operation has been applied
+type bulkResultMsg []utils.FlagToggleResult
+
+// applyBulkToggles applies flag toggles with a bounded worker pool
+func applyBulkToggles(c client.PostHogClient, toggles []utils.FlagToggle) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		return bulkResultMsg(utils.ApplyFlagToggles(ctx, c, toggles, utils.DefaultBulkWorkers))
+	}
+}
+
+// toggleFlagMark marks or unmarks the flag under the cursor and moves down
+func (m *Model) toggleFlagMark() {
+	items := m.getEffectiveListItems()
+	if m.listCursor >= len(items) {
+		return
+	}
+	f, ok := items[m.listCursor].(FlagListItem)
+	if !ok {
+		return
+	}
+
+	if m.markedFlags[f.Flag.ID] {
+		delete(m.markedFlags, f.Flag.ID)
+	} else {
+		m.markedFlags[f.Flag.ID] = true
+	}
+	m.refreshFlagMarks()
+	m.MoveListCursorDown()
+}
+
+// toggleAllFlagMarks marks every visible flag, or clears all marks if they
+// are all marked already. Combined with search this selects e.g. "checkout-".
+func (m *Model) toggleAllFlagMarks() {
+	items := m.getEffectiveListItems()
+
+	allMarked := len(items) > 0
+	for _, item := range items {
+		if f, ok := item.(FlagListItem); ok && !m.markedFlags[f.Flag.ID] {
+			allMarked = false
+			break
+		}
+	}
+
+	for _, item := range items {
+		if f, ok := item.(FlagListItem); ok {
+			if allMarked {
+				delete(m.markedFlags, f.Flag.ID)
+			} else {
+				m.markedFlags[f.Flag.ID] = true
+			}
+		}
+	}
+	m.refreshFlagMarks()
+}
+
+// refreshFlagMarks syncs the Marked state of list items with markedFlags
+func (m *Model) refreshFlagMarks() {
+	for _, items := range [][]ListItem{m.listItems, m.filteredItems} {
+		for i, item := range items {
+			if f, ok := item.(FlagListItem); ok {
+				f.Marked = m.markedFlags[f.Flag.ID]
+				items[i] = f
+			}
+		}
+	}
+}
+
+// startBulkToggle opens a preview for setting the marked flags (or the flag
+// under the cursor if none are marked) to active
+func (m *Model) startBulkToggle(active bool) {
+	var flags []client.FeatureFlag
+	for _, item := range m.listItems {
+		if f, ok := item.(FlagListItem); ok && m.markedFlags[f.Flag.ID] {
+			flags = append(flags, f.Flag)
+		}
+	}
+
+	if len(flags) == 0 {
+		items := m.getEffectiveListItems()
+		if m.listCursor < len(items) {
+			if f, ok := items[m.listCursor].(FlagListItem); ok {
+				flags = append(flags, f.Flag)
+			}
+		}
+	}
+
+	toggles := utils.PlanFlagToggles(flags, active)
+	if len(toggles) == 0 {
+		m.showClipboardFeedback("Nothing to change")
+		return
+	}
+
+	m.bulk = &bulkOperation{toggles: toggles}
+}
+
+// handleBulkKeys handles keyboard input while the bulk overlay is shown
+func (m Model) handleBulkKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	// Ignore input while requests are in flight
+	if m.bulk.applying {
+		return m, nil
+	}
+
+	if m.bulk.results == nil {
+		// Preview: confirm or cancel
+		switch msg.String() {
+		case "y", "enter":
+			m.bulk.applying = true
+			return m, applyBulkToggles(m.client, m.bulk.toggles)
+		case "n", "esc", "q":
+			m.bulk = nil
+		}
+		return m, nil
+	}
+
+	// Results: roll back or close
+	switch msg.String() {
+	case "u":
+		if m.bulk.rollback {
+			return m, nil
+		}
+		rollback := utils.RollbackToggles(m.bulk.results)
+		if len(rollback) == 0 {
+			return m, nil
+		}
+		m.bulk = &bulkOperation{toggles: rollback, applying: true, rollback: true}
+		return m, applyBulkToggles(m.client, rollback)
+	case "enter", "esc", "q":
+		m.bulk = nil
+		m.loading = true
+		return m, m.fetchCurrentResource()
+	}
+
+	return m, nil
+}
+
+// applyBulkResults updates the flag list with the outcome of a bulk operation
+func (m *Model) applyBulkResults(results []utils.FlagToggleResult) {
+	if m.bulk == nil {
+		return
+	}
+	m.bulk.results = results
+	m.bulk.applying = false
+
+	updated := make(map[int]bool)
+	for _, r := range results {
+		if r.Err == nil {
+			updated[r.Flag.ID] = r.Active
+		}
+	}
+
+	for _, items := range [][]ListItem{m.listItems, m.filteredItems} {
+		for i, item := range items {
+			if f, ok := item.(FlagListItem); ok {
+				if active, ok := updated[f.Flag.ID]; ok {
+					f.Flag.Active = active
+					items[i] = f
+				}
+			}
+		}
+	}
+
+	// Marks have served their purpose once a batch is applied
+	m.markedFlags = make(map[int]bool)
+	m.refreshFlagMarks()
+	m.updateInspectorFromCursor()
+}
+
+// renderBulkOverlay renders the preview or results of a bulk operation
+func (m Model) renderBulkOverlay(width, height int) string {
+	var sb strings.Builder
+
+	op := m.bulk
+	title := "Bulk Flag Update"
+	if op.rollback {
+		title = "Rollback"
+	}
+	sb.WriteString(styles.TitleStyle.Render(title))
+	sb.WriteString("\n\n")
+
+	// Table header
+	sb.WriteString(styles.JSONKeyStyle.Render(fmt.Sprintf("  %-40s %-10s %-10s %s", "KEY", "CURRENT", "NEW", "RESULT")))
+	sb.WriteString("\n")
+
+	for i, toggle := range op.toggles {
+		result := ""
+		switch {
+		case op.applying:
+			result = m.spinner.View()
+		case op.results != nil && op.results[i].Err != nil:
+			result = styles.ErrorTextStyle.Render("✗ " + op.results[i].Err.Error())
+		case op.results != nil:
+			result = styles.SuccessTextStyle.Render("✓")
+		}
+
+		key := styles.TruncateString(toggle.Flag.Key, 40)
+		sb.WriteString(fmt.Sprintf("  %-40s %-10s %-10s %s\n",
+			key, utils.FlagStateLabel(toggle.Flag.Active), utils.FlagStateLabel(toggle.Active), result))
+	}
+	sb.WriteString("\n")
+
+	switch {
+	case op.applying:
+		sb.WriteString(m.spinner.View() + fmt.Sprintf(" Applying %d changes...", len(op.toggles)))
+	case op.results == nil:
+		sb.WriteString(styles.WarningTextStyle.Render(fmt.Sprintf("Apply %d changes?", len(op.toggles))))
+		sb.WriteString("\n\n")
+		sb.WriteString(styles.KeyStyle.Render("y/Enter") + " apply • " + styles.KeyStyle.Render("n/Esc") + " cancel")
+	default:
+		failed := utils.CountFailures(op.results)
+		summary := fmt.Sprintf("%d succeeded, %d failed", len(op.results)-failed, failed)
+		if failed > 0 {
+			sb.WriteString(styles.ErrorTextStyle.Render(summary))
+		} else {
+			sb.WriteString(styles.SuccessTextStyle.Render(summary))
+		}
+		sb.WriteString("\n\n")
+		if !op.rollback && failed < len(op.results) {
+			sb.WriteString(styles.KeyStyle.Render("u") + " roll back batch • ")
+		}
+		sb.WriteString(styles.KeyStyle.Render("Enter/Esc") + " close")
+	}
+
+	overlayStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorWarning).
+		Padding(1, 2).
+		Width(width - 4).
+		Height(height - 4)
+
+	return overlayStyle.Render(sb.String())
+}
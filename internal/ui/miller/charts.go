@@ -0,0 +1,239 @@
+package miller
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/components"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+)
+
+const (
+	// maxChartSeries is the number of trend series drawn before the rest are summarized
+	maxChartSeries = 8
+
+	// maxTableRows is the number of result rows shown for table insights
+	maxTableRows = 10
+
+	// chartLabelWidth is the width of the label column of bar charts
+	chartLabelWidth = 20
+)
+
+// renderInsightChart renders an insight's cached result as a terminal chart:
+// sparklines for trends, stepped bars for funnels and a table otherwise.
+// Trends list the annotations within their range, with their buckets in the
+// project's timezone loc.
+func renderInsightChart(insight client.Insight, annotations []client.Annotation, loc *time.Location, width int) []string {
+	if !insight.HasResult() {
+		return []string{styles.DimTextStyle.Render("No cached result - refresh the insight in PostHog")}
+	}
+
+	switch insight.Kind() {
+	case client.InsightTrends:
+		series, err := insight.TrendSeries()
+		if err != nil {
+			return []string{styles.ErrorTextStyle.Render(err.Error())}
+		}
+		switch insight.Display() {
+		case "BoldNumber", "ActionsBarValue", "ActionsPie", "ActionsTable", "WorldMap":
+			return renderTrendTotals(series, annotations, loc, width)
+		default:
+			return renderTrendSparklines(series, annotations, loc, width)
+		}
+
+	case client.InsightFunnels:
+		steps, err := insight.FunnelSteps()
+		if err != nil {
+			return []string{styles.ErrorTextStyle.Render(err.Error())}
+		}
+		return renderFunnelBars(steps, width)
+
+	default:
+		return renderResultTable(insight.Result, width)
+	}
+}
+
+// trendSeriesLabel returns a series label including its breakdown value
+func trendSeriesLabel(s client.TrendSeries) string {
+	label := s.Label
+	if s.BreakdownValue != nil {
+		if breakdown := utils.FormatJSONValue(s.BreakdownValue); !strings.Contains(label, breakdown) {
+			label += " - " + breakdown
+		}
+	}
+	return label
+}
+
+// renderTrendSparklines renders each trend series as a labelled sparkline,
+// with markers of the annotations within its range
+func renderTrendSparklines(series []client.TrendSeries, annotations []client.Annotation, loc *time.Location, width int) []string {
+	if len(series) == 0 {
+		return []string{styles.DimTextStyle.Render("No data")}
+	}
+
+	var lines []string
+	for i, s := range series {
+		if i == maxChartSeries {
+			lines = append(lines, styles.DimTextStyle.Render(fmt.Sprintf("+%d more series", len(series)-maxChartSeries)))
+			break
+		}
+
+		lines = append(lines, fmt.Sprintf("%s %s", trendSeriesLabel(s),
+			styles.DimTextStyle.Render(fmt.Sprintf("(total %s)", formatChartValue(s.Total())))))
+		lines = append(lines, components.Sparkline(s.Data, width))
+		if buckets := s.Buckets(loc); len(buckets) == len(s.Data) {
+			if markers, _ := renderSparklineMarkers(annotations, buckets, width); markers != "" {
+				lines = append(lines, markers)
+			}
+		}
+
+		if len(s.Labels) > 1 {
+			lines = append(lines, styles.DimTextStyle.Render(
+				fmt.Sprintf("%s … %s", s.Labels[0], s.Labels[len(s.Labels)-1])))
+		}
+	}
+
+	return append(lines, trendAnnotationLegend(series, annotations, loc, width)...)
+}
+
+// trendAnnotationLegend lists the annotations within the range of a trend's
+// series, numbered like their markers
+func trendAnnotationLegend(series []client.TrendSeries, annotations []client.Annotation, loc *time.Location, width int) []string {
+	if len(series) == 0 {
+		return nil
+	}
+	_, legend := renderSparklineMarkers(annotations, series[0].Buckets(loc), width)
+	return legend
+}
+
+// renderTrendTotals renders each trend series' total as a horizontal bar,
+// followed by the annotations within the range totalled
+func renderTrendTotals(series []client.TrendSeries, annotations []client.Annotation, loc *time.Location, width int) []string {
+	if len(series) == 0 {
+		return []string{styles.DimTextStyle.Render("No data")}
+	}
+
+	max := 0.0
+	for _, s := range series {
+		if s.Total() > max {
+			max = s.Total()
+		}
+	}
+
+	barWidth := styles.Max(10, width-chartLabelWidth-12)
+	var lines []string
+	for i, s := range series {
+		if i == maxChartSeries {
+			lines = append(lines, styles.DimTextStyle.Render(fmt.Sprintf("+%d more series", len(series)-maxChartSeries)))
+			break
+		}
+
+		fraction := 0.0
+		if max > 0 {
+			fraction = s.Total() / max
+		}
+		label := styles.TruncateString(trendSeriesLabel(s), chartLabelWidth)
+		lines = append(lines, fmt.Sprintf("%-*s %s %s", chartLabelWidth, label,
+			components.RenderBar(fraction, barWidth), formatChartValue(s.Total())))
+	}
+
+	return append(lines, trendAnnotationLegend(series, annotations, loc, width)...)
+}
+
+// renderFunnelBars renders funnel steps as stepped bars relative to the
+// first step, with conversion from the previous step and median time
+func renderFunnelBars(steps []client.FunnelStep, width int) []string {
+	if len(steps) == 0 || steps[0].Count == 0 {
+		return []string{styles.DimTextStyle.Render("No conversions")}
+	}
+
+	barWidth := styles.Max(10, width-20)
+	first := steps[0].Count
+
+	var lines []string
+	for i, step := range steps {
+		lines = append(lines, fmt.Sprintf("%d. %s", i+1, step.DisplayName()))
+
+		detail := fmt.Sprintf("%s (%.1f%%)", formatChartValue(step.Count), step.Count/first*100)
+		if i > 0 && steps[i-1].Count > 0 {
+			detail += styles.DimTextStyle.Render(fmt.Sprintf("  %.1f%% from previous", step.Count/steps[i-1].Count*100))
+		}
+		if step.MedianConversionTime != nil {
+			median := time.Duration(*step.MedianConversionTime * float64(time.Second))
+			detail += styles.DimTextStyle.Render("  median " + utils.FormatDuration(median))
+		}
+
+		lines = append(lines, "   "+components.RenderBar(step.Count/first, barWidth))
+		lines = append(lines, "   "+detail)
+	}
+
+	last := steps[len(steps)-1].Count
+	lines = append(lines, "")
+	lines = append(lines, styles.JSONKeyStyle.Render("Overall conversion: ")+fmt.Sprintf("%.1f%%", last/first*100))
+
+	return lines
+}
+
+// renderResultTable renders a raw insight result as rows of values
+func renderResultTable(result json.RawMessage, width int) []string {
+	var rows []interface{}
+	if err := json.Unmarshal(result, &rows); err != nil {
+		var value interface{}
+		if err := json.Unmarshal(result, &value); err != nil {
+			return []string{styles.ErrorTextStyle.Render("Unsupported result")}
+		}
+		return []string{styles.TruncateString(utils.FormatJSONValue(value), width)}
+	}
+
+	if len(rows) == 0 {
+		return []string{styles.DimTextStyle.Render("No rows")}
+	}
+
+	var lines []string
+	for i, row := range rows {
+		if i == maxTableRows {
+			lines = append(lines, styles.DimTextStyle.Render(fmt.Sprintf("+%d more rows", len(rows)-maxTableRows)))
+			break
+		}
+
+		var cells []string
+		switch r := row.(type) {
+		case []interface{}:
+			for _, cell := range r {
+				cells = append(cells, utils.FormatJSONValue(cell))
+			}
+		case map[string]interface{}:
+			for _, key := range sortedKeys(r) {
+				cells = append(cells, key+"="+utils.FormatJSONValue(r[key]))
+			}
+		default:
+			cells = append(cells, utils.FormatJSONValue(r))
+		}
+		lines = append(lines, styles.TruncateString(strings.Join(cells, "  "), width))
+	}
+
+	return lines
+}
+
+// formatChartValue renders a chart value without needless decimals
+func formatChartValue(v float64) string {
+	if v == float64(int64(v)) {
+		return fmt.Sprintf("%d", int64(v))
+	}
+	return fmt.Sprintf("%.2f", v)
+}
+
+// sortedKeys returns the keys of a map in sorted order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
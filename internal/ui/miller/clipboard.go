@@ -8,6 +8,7 @@ import (
 
 	"github.com/aymanbagabas/go-osc52/v2"
 	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
 )
 
 // CopyToClipboard copies text to the system clipboard using OSC 52 escape sequences
@@ -56,6 +57,56 @@ func (m Model) extractCopyableID() string {
 		if flag, ok := m.inspectorData.(client.FeatureFlag); ok {
 			return flag.Key
 		}
+
+	case ResourceFlagAudit:
+		if row, ok := m.inspectorData.(utils.FlagAuditRow); ok {
+			return row.Flag.Key
+		}
+
+	case ResourceExperiments:
+		if exp, ok := m.inspectorData.(client.Experiment); ok {
+			return exp.FeatureFlagKey
+		}
+
+	case ResourceCohorts:
+		if cohort, ok := m.inspectorData.(client.Cohort); ok {
+			return fmt.Sprintf("%d", cohort.ID)
+		}
+
+	case ResourceDashboards:
+		if dashboard, ok := m.inspectorData.(client.Dashboard); ok {
+			return fmt.Sprintf("%d", dashboard.ID)
+		}
+
+	case ResourceSchema:
+		if definition, ok := m.inspectorData.(client.EventDefinition); ok {
+			return definition.Name
+		}
+
+	case ResourceActions:
+		if action, ok := m.inspectorData.(client.Action); ok {
+			return fmt.Sprintf("%d", action.ID)
+		}
+
+	case ResourceAnnotations:
+		if annotation, ok := m.inspectorData.(client.Annotation); ok {
+			return fmt.Sprintf("%d", annotation.ID)
+		}
+
+	case ResourceSurveys:
+		if survey, ok := m.inspectorData.(client.Survey); ok {
+			return survey.ID
+		}
+
+	case ResourceExceptions:
+		if group, ok := m.inspectorData.(client.ExceptionGroup); ok {
+			return group.Key
+		}
+
+	case ResourceGroups:
+		if group, ok := m.inspectorData.(client.Group); ok {
+			return group.Key
+		}
 	}
 
 	return ""
@@ -0,0 +1,494 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// maxCohortNameLen is the truncation length of cohort names in the list
+	maxCohortNameLen = 30
+
+	// cohortMembersPageSize is the number of members fetched per page
+	cohortMembersPageSize = 25
+)
+
+// cohortMembers holds the currently loaded page of a cohort's members
+type cohortMembers struct {
+	persons []client.Person
+	offset  int
+	hasMore bool
+	loading bool
+	err     error
+}
+
+// personCohorts holds the cohorts a person belongs to
+type personCohorts struct {
+	cohorts []client.Cohort
+	loading bool
+	err     error
+}
+
+// cohortsMsg is sent when the cohorts list has been fetched
+type cohortsMsg []client.Cohort
+
+// cohortNamesMsg is sent when cohorts have been fetched to resolve IDs
+// referenced elsewhere (e.g. in flag release conditions)
+type cohortNamesMsg struct {
+	cohorts []client.Cohort
+	err     error
+}
+
+// cohortMembersMsg is sent when a page of cohort members has been fetched
+type cohortMembersMsg struct {
+	cohortID int
+	offset   int
+	persons  []client.Person
+	hasMore  bool
+	err      error
+}
+
+// personCohortsMsg is sent when a person's cohorts have been fetched
+type personCohortsMsg struct {
+	personUUID string
+	cohorts    []client.Cohort
+	err        error
+}
+
+func fetchCohorts(c client.PostHogClient) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		cohorts, err := c.ListCohorts(ctx)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		return cohortsMsg(cohorts)
+	}
+}
+
+// fetchCohortNames fetches cohorts in the background to resolve cohort IDs
+func fetchCohortNames(c client.PostHogClient) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		cohorts, err := c.ListCohorts(ctx)
+		return cohortNamesMsg{cohorts: cohorts, err: err}
+	}
+}
+
+// fetchCohortMembers fetches one page of a cohort's members
+func fetchCohortMembers(c client.PostHogClient, cohortID, offset int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		persons, hasMore, err := c.ListCohortPersons(ctx, cohortID, cohortMembersPageSize, offset)
+		return cohortMembersMsg{cohortID: cohortID, offset: offset, persons: persons, hasMore: hasMore, err: err}
+	}
+}
+
+// fetchPersonCohorts fetches the cohorts a person belongs to
+func fetchPersonCohorts(c client.PostHogClient, personUUID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		cohorts, err := c.GetPersonCohorts(ctx, personUUID)
+		return personCohortsMsg{personUUID: personUUID, cohorts: cohorts, err: err}
+	}
+}
+
+// setCohortNames indexes cohort names by ID for resolving references
+func (m *Model) setCohortNames(cohorts []client.Cohort) {
+	m.cohortNames = make(map[int]string, len(cohorts))
+	for _, cohort := range cohorts {
+		m.cohortNames[cohort.ID] = cohort.Name
+	}
+}
+
+// personUUID returns the UUID used to look up a person's cohorts
+func personUUID(person client.Person) string {
+	if person.UUID != "" {
+		return person.UUID
+	}
+	return person.ID
+}
+
+// pageCohortMembers loads the next (delta > 0) or previous (delta < 0) page
+// of members of the cohort in the inspector
+func (m *Model) pageCohortMembers(delta int) tea.Cmd {
+	cohort, ok := m.inspectorData.(client.Cohort)
+	if !ok || m.activeInspectorTab() != TabMembers {
+		return nil
+	}
+
+	page := m.cohortMembers[cohort.ID]
+	if page.loading || (delta > 0 && !page.hasMore) || (delta < 0 && page.offset == 0) {
+		return nil
+	}
+
+	offset := styles.Max(0, page.offset+delta*cohortMembersPageSize)
+	m.cohortMembers[cohort.ID] = cohortMembers{persons: page.persons, offset: page.offset, loading: true}
+	m.inspectorViewport.GotoTop()
+	return fetchCohortMembers(m.client, cohort.ID, offset)
+}
+
+// CohortListItem wraps a client.Cohort for list display
+type CohortListItem struct {
+	Cohort client.Cohort
+}
+
+func (c CohortListItem) RenderLine(width int, selected bool) string {
+	name := c.Cohort.Name
+	if len(name) > maxCohortNameLen {
+		name = styles.TruncateString(name, maxCohortNameLen)
+	}
+
+	icon := "↻"
+	if c.Cohort.IsStatic {
+		icon = "▤"
+	}
+
+	line := fmt.Sprintf("%s %s %s", icon, name, styles.DimTextStyle.Render(formatCohortCount(c.Cohort)))
+
+	if selected {
+		line = styles.SelectedListItemStyle.Render("▶ " + line)
+	} else {
+		line = styles.ListItemStyle.Render("  " + line)
+	}
+
+	return line
+}
+
+func (c CohortListItem) GetID() string {
+	return fmt.Sprintf("%d", c.Cohort.ID)
+}
+
+func (c CohortListItem) GetInspectorData() interface{} {
+	return c.Cohort
+}
+
+func (c CohortListItem) GetDistinctID() string {
+	return "" // Cohorts don't have distinct IDs
+}
+
+func (c CohortListItem) GetSearchableText() string {
+	return c.Cohort.Name + " " + c.Cohort.Kind() + " " + c.Cohort.Description
+}
+
+// formatCohortCount renders a cohort's member count
+func formatCohortCount(cohort client.Cohort) string {
+	switch {
+	case cohort.IsCalculating:
+		return "calculating"
+	case cohort.Count == nil:
+		return "-"
+	case *cohort.Count == 1:
+		return "1 person"
+	default:
+		return fmt.Sprintf("%d persons", *cohort.Count)
+	}
+}
+
+// renderCohortInspectorScrollable renders cohort details and its definition
+func (m Model) renderCohortInspectorScrollable(width, height int) string {
+	cohort, ok := m.inspectorData.(client.Cohort)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid cohort data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Name: ")+cohort.Name)
+	lines = append(lines, styles.JSONKeyStyle.Render("ID: ")+fmt.Sprintf("%d", cohort.ID))
+	lines = append(lines, styles.JSONKeyStyle.Render("Type: ")+cohort.Kind())
+	lines = append(lines, styles.JSONKeyStyle.Render("Members: ")+formatCohortCount(cohort))
+
+	lastCalculated := styles.DimTextStyle.Render("never")
+	if cohort.LastCalculation != nil {
+		lastCalculated = client.FormatEventTime(cohort.LastCalculation.Local()) +
+			styles.DimTextStyle.Render(" ("+utils.FormatDuration(time.Since(*cohort.LastCalculation))+" ago)")
+	}
+	lines = append(lines, styles.JSONKeyStyle.Render("Last calculated: ")+lastCalculated)
+
+	if cohort.Description != "" {
+		lines = append(lines, "")
+		lines = append(lines, cohort.Description)
+	}
+	lines = append(lines, "")
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Definition:"))
+	for _, line := range utils.DescribeCohort(cohort, m.cohortNames) {
+		lines = append(lines, "  "+line)
+	}
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// renderCohortMembersScrollable renders the current page of a cohort's members
+func (m Model) renderCohortMembersScrollable(width, height int) string {
+	cohort, ok := m.inspectorData.(client.Cohort)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid cohort data")
+	}
+
+	page, loaded := m.cohortMembers[cohort.ID]
+	var lines []string
+
+	switch {
+	case !loaded || page.loading:
+		lines = append(lines, m.spinner.View()+" Loading members...")
+	case page.err != nil:
+		lines = append(lines, styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", page.err)))
+	case len(page.persons) == 0:
+		lines = append(lines, styles.DimTextStyle.Render("No members"))
+	default:
+		header := fmt.Sprintf("Members %d–%d", page.offset+1, page.offset+len(page.persons))
+		if cohort.Count != nil {
+			header += fmt.Sprintf(" of %d", *cohort.Count)
+		}
+		lines = append(lines, styles.JSONKeyStyle.Render(header))
+		lines = append(lines, "")
+
+		for _, person := range page.persons {
+			lines = append(lines, "  "+personLabel(person))
+		}
+
+		var hints []string
+		if page.offset > 0 {
+			hints = append(hints, styles.KeyStyle.Render("N")+" previous page")
+		}
+		if page.hasMore {
+			hints = append(hints, styles.KeyStyle.Render("n")+" next page")
+		}
+		if len(hints) > 0 {
+			lines = append(lines, "")
+			lines = append(lines, styles.DimTextStyle.Render(strings.Join(hints, " • ")))
+		}
+	}
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// personLabel renders a person as "name (email) distinct_id"
+func personLabel(person client.Person) string {
+	name := person.Name
+	if name == "" {
+		name = "(no name)"
+	}
+	if email, ok := person.Properties["email"].(string); ok && email != "" && email != name {
+		name += " <" + email + ">"
+	}
+
+	distinctID := ""
+	if len(person.DistinctIDs) > 0 {
+		distinctID = person.DistinctIDs[0]
+	}
+
+	return name + " " + styles.DimTextStyle.Render(distinctID)
+}
+
+// renderPersonCohorts renders the cohorts section of the person inspector
+func (m Model) renderPersonCohorts(person client.Person) []string {
+	result, loaded := m.personCohorts[personUUID(person)]
+	switch {
+	case !loaded || result.loading:
+		return []string{"  " + m.spinner.View() + " Loading cohorts..."}
+	case result.err != nil:
+		return []string{styles.ErrorTextStyle.Render(fmt.Sprintf("  Error: %v", result.err))}
+	case len(result.cohorts) == 0:
+		return []string{styles.DimTextStyle.Render("  (not in any cohort)")}
+	}
+
+	lines := make([]string, 0, len(result.cohorts))
+	for _, cohort := range result.cohorts {
+		lines = append(lines, fmt.Sprintf("  • %s %s", cohort.Name,
+			styles.DimTextStyle.Render(fmt.Sprintf("#%d %s", cohort.ID, cohort.Kind()))))
+	}
+	return lines
+}
+
+// renderFlagReleaseConditions renders a flag's release condition sets
+// readably, resolving referenced cohort IDs to names
+func (m Model) renderFlagReleaseConditions(flag client.FeatureFlag) []string {
+	groups, _ := flag.Filters["groups"].([]interface{})
+	if len(groups) == 0 {
+		return nil
+	}
+
+	var lines []string
+	for i, g := range groups {
+		group, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		condition := "everyone"
+		if properties, ok := group["properties"].([]interface{}); ok && len(properties) > 0 {
+			condition = utils.DescribePropertyList(properties, m.cohortNames)
+		}
+
+		rollout := "100%"
+		if r, ok := group["rollout_percentage"].(float64); ok {
+			rollout = fmt.Sprintf("%.0f%%", r)
+		}
+
+		lines = append(lines, fmt.Sprintf("  %d. %s %s", i+1, condition, styles.DimTextStyle.Render("→ "+rollout)))
+	}
+
+	if m.cohortNamesLoading {
+		lines = append(lines, "  "+m.spinner.View()+styles.DimTextStyle.Render(" Resolving cohort names..."))
+	}
+
+	return lines
+}
+
+// staticCohortMsg is sent when a static cohort has been created from a selection
+type staticCohortMsg struct {
+	cohort *client.Cohort
+	added  int
+	err    error
+}
+
+// createStaticCohort creates a static cohort containing the given persons
+func createStaticCohort(c client.PostHogClient, name string, members utils.CohortMembers) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		cohort, added, err := utils.CreateStaticCohort(ctx, c, name, members)
+		return staticCohortMsg{cohort: cohort, added: added, err: err}
+	}
+}
+
+// togglePersonMark marks or unmarks the person under the cursor and moves down
+func (m *Model) togglePersonMark() {
+	items := m.getEffectiveListItems()
+	if m.listCursor >= len(items) {
+		return
+	}
+	p, ok := items[m.listCursor].(PersonListItem)
+	if !ok {
+		return
+	}
+
+	id := personUUID(p.Person)
+	if m.markedPersons[id] {
+		delete(m.markedPersons, id)
+	} else {
+		m.markedPersons[id] = true
+	}
+	m.refreshPersonMarks()
+	m.MoveListCursorDown()
+}
+
+// toggleAllPersonMarks marks every visible person, or clears all marks if
+// they are all marked already
+func (m *Model) toggleAllPersonMarks() {
+	items := m.getEffectiveListItems()
+
+	allMarked := len(items) > 0
+	for _, item := range items {
+		if p, ok := item.(PersonListItem); ok && !m.markedPersons[personUUID(p.Person)] {
+			allMarked = false
+			break
+		}
+	}
+
+	for _, item := range items {
+		if p, ok := item.(PersonListItem); ok {
+			if allMarked {
+				delete(m.markedPersons, personUUID(p.Person))
+			} else {
+				m.markedPersons[personUUID(p.Person)] = true
+			}
+		}
+	}
+	m.refreshPersonMarks()
+}
+
+// refreshPersonMarks syncs the Marked state of list items with markedPersons
+func (m *Model) refreshPersonMarks() {
+	for _, items := range [][]ListItem{m.listItems, m.filteredItems} {
+		for i, item := range items {
+			if p, ok := item.(PersonListItem); ok {
+				p.Marked = m.markedPersons[personUUID(p.Person)]
+				items[i] = p
+			}
+		}
+	}
+}
+
+// startStaticCohort prompts for a name and creates a static cohort from the
+// marked persons, including those marked in earlier searches (or the person
+// under the cursor if none are marked)
+func (m *Model) startStaticCohort() {
+	var members utils.CohortMembers
+	for id := range m.markedPersons {
+		members.PersonIDs = append(members.PersonIDs, id)
+	}
+	sort.Strings(members.PersonIDs)
+
+	if len(members.PersonIDs) == 0 {
+		items := m.getEffectiveListItems()
+		if m.listCursor < len(items) {
+			if p, ok := items[m.listCursor].(PersonListItem); ok {
+				members.PersonIDs = append(members.PersonIDs, personUUID(p.Person))
+			}
+		}
+	}
+
+	if len(members.PersonIDs) == 0 {
+		return
+	}
+
+	placeholder := fmt.Sprintf("static cohort of %d persons", len(members.PersonIDs))
+	m.openPrompt("Cohort name", placeholder, func(m *Model, name string) tea.Cmd {
+		m.showClipboardFeedback("Creating cohort...")
+		return createStaticCohort(m.client, name, members)
+	})
+}
+
+// applyStaticCohort reports the outcome of creating a static cohort
+func (m *Model) applyStaticCohort(msg staticCohortMsg) {
+	if msg.cohort == nil {
+		m.showClipboardFeedback(fmt.Sprintf("Cohort creation failed: %v", msg.err))
+		return
+	}
+
+	// The new cohort should show up in cohort lookups
+	m.cohortNames = nil
+	m.personCohorts = make(map[string]personCohorts)
+
+	if msg.err != nil {
+		m.showClipboardFeedback(fmt.Sprintf("Cohort #%d created, %d persons added: %v", msg.cohort.ID, msg.added, msg.err))
+		return
+	}
+
+	m.markedPersons = make(map[string]bool)
+	m.refreshPersonMarks()
+	m.showClipboardFeedback(fmt.Sprintf("Created cohort %s (#%d, %d persons)", msg.cohort.Name, msg.cohort.ID, msg.added))
+}
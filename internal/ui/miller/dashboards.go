@@ -0,0 +1,189 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxDashboardNameLen is the truncation length of dashboard names in the list
+const maxDashboardNameLen = 35
+
+// dashboardDetail holds a dashboard fetched with its tiles
+type dashboardDetail struct {
+	dashboard *client.Dashboard
+	loading   bool
+	err       error
+}
+
+// dashboardsMsg is sent when the dashboards list has been fetched
+type dashboardsMsg []client.Dashboard
+
+// dashboardDetailMsg is sent when a dashboard's tiles have been fetched
+type dashboardDetailMsg struct {
+	dashboardID int
+	dashboard   *client.Dashboard
+	err         error
+}
+
+func fetchDashboards(c client.PostHogClient) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		dashboards, err := c.ListDashboards(ctx)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		return dashboardsMsg(dashboards)
+	}
+}
+
+// fetchDashboardDetail fetches a dashboard with its tiles and cached results
+func fetchDashboardDetail(c client.PostHogClient, dashboardID int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		dashboard, err := c.GetDashboard(ctx, dashboardID)
+		return dashboardDetailMsg{dashboardID: dashboardID, dashboard: dashboard, err: err}
+	}
+}
+
+// DashboardListItem wraps a client.Dashboard for list display
+type DashboardListItem struct {
+	Dashboard client.Dashboard
+}
+
+func (d DashboardListItem) RenderLine(width int, selected bool) string {
+	name := d.Dashboard.Name
+	if len(name) > maxDashboardNameLen {
+		name = styles.TruncateString(name, maxDashboardNameLen)
+	}
+
+	pin := " "
+	if d.Dashboard.Pinned {
+		pin = styles.WarningTextStyle.Render("★")
+	}
+
+	line := fmt.Sprintf("%s %s", pin, name)
+	if len(d.Dashboard.Tags) > 0 {
+		line += " " + styles.DimTextStyle.Render(strings.Join(d.Dashboard.Tags, ","))
+	}
+
+	if selected {
+		line = styles.SelectedListItemStyle.Render("▶ " + line)
+	} else {
+		line = styles.ListItemStyle.Render("  " + line)
+	}
+
+	return line
+}
+
+func (d DashboardListItem) GetID() string {
+	return fmt.Sprintf("%d", d.Dashboard.ID)
+}
+
+func (d DashboardListItem) GetInspectorData() interface{} {
+	return d.Dashboard
+}
+
+func (d DashboardListItem) GetDistinctID() string {
+	return "" // Dashboards don't have distinct IDs
+}
+
+func (d DashboardListItem) GetSearchableText() string {
+	return d.Dashboard.Name + " " + strings.Join(d.Dashboard.Tags, " ") + " " + d.Dashboard.Description
+}
+
+// renderDashboardInspectorScrollable renders a dashboard's tiles as charts
+func (m Model) renderDashboardInspectorScrollable(width, height int) string {
+	summary, ok := m.inspectorData.(client.Dashboard)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid dashboard data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Dashboard: ")+summary.Name)
+	if summary.Description != "" {
+		lines = append(lines, summary.Description)
+	}
+	lines = append(lines, "")
+
+	detail, loaded := m.dashboards[summary.ID]
+	switch {
+	case !loaded || detail.loading:
+		lines = append(lines, m.spinner.View()+" Loading tiles...")
+	case detail.err != nil:
+		lines = append(lines, styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", detail.err)))
+	default:
+		lines = append(lines, renderDashboardTiles(detail.dashboard.Tiles, m.chartAnnotations(), m.projectLocation(), width-10)...)
+	}
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// renderDashboardTiles renders an index of the tiles followed by each
+// insight's chart, marking annotations on trends bucketed in the project's
+// timezone loc
+func renderDashboardTiles(tiles []client.DashboardTile, annotations []client.Annotation, loc *time.Location, chartWidth int) []string {
+	var insights []client.Insight
+	var texts []string
+	for _, tile := range tiles {
+		switch {
+		case tile.Deleted:
+		case tile.Insight != nil:
+			insights = append(insights, *tile.Insight)
+		case tile.Text != nil && tile.Text.Body != "":
+			texts = append(texts, tile.Text.Body)
+		}
+	}
+
+	if len(insights) == 0 && len(texts) == 0 {
+		return []string{styles.DimTextStyle.Render("This dashboard has no tiles")}
+	}
+
+	var lines []string
+
+	// Tile index
+	lines = append(lines, styles.JSONKeyStyle.Render(fmt.Sprintf("Insights (%d):", len(insights))))
+	for i, insight := range insights {
+		lines = append(lines, fmt.Sprintf("  %d. %s %s", i+1, insight.DisplayName(),
+			styles.DimTextStyle.Render(string(insight.Kind()))))
+	}
+
+	for _, text := range texts {
+		lines = append(lines, "")
+		lines = append(lines, styles.DimTextStyle.Render(text))
+	}
+
+	// Charts
+	for i, insight := range insights {
+		lines = append(lines, "")
+		lines = append(lines, styles.DimTextStyle.Render(strings.Repeat("─", styles.Max(10, chartWidth))))
+		lines = append(lines, styles.HighlightTextStyle.Render(fmt.Sprintf("%d. %s", i+1, insight.DisplayName())))
+		if insight.Description != "" {
+			lines = append(lines, styles.DimTextStyle.Render(insight.Description))
+		}
+		for _, line := range renderInsightChart(insight, annotations, loc, chartWidth) {
+			lines = append(lines, "  "+line)
+		}
+		if insight.LastRefresh != nil {
+			lines = append(lines, styles.CaptionStyle.Render("  Last refreshed "+*insight.LastRefresh))
+		}
+	}
+
+	return lines
+}
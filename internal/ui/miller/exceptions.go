@@ -0,0 +1,289 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/components"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// maxExceptionGroups is the number of most frequent exception groups listed
+	maxExceptionGroups = 200
+	// maxExceptionTitleLen is the truncation length of exceptions in the list
+	maxExceptionTitleLen = 50
+)
+
+// exceptionWindows are the windows (in days) cycled with 'd'
+var exceptionWindows = []int{7, 30, 90}
+
+// exceptionDetail holds an exception group's daily trend and latest occurrence
+type exceptionDetail struct {
+	trend   []client.ExceptionTrendPoint
+	latest  *client.Event
+	loading bool
+	err     error
+}
+
+// exceptionsMsg is sent when the exception groups have been fetched
+type exceptionsMsg []client.ExceptionGroup
+
+// exceptionDetailMsg is sent when an exception group's trend and latest
+// occurrence have been fetched
+type exceptionDetailMsg struct {
+	key    string
+	trend  []client.ExceptionTrendPoint
+	latest *client.Event
+	err    error
+}
+
+func fetchExceptions(c client.PostHogClient, days int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		groups, err := c.ListExceptionGroups(ctx, days, maxExceptionGroups)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		return exceptionsMsg(groups)
+	}
+}
+
+// fetchExceptionDetail fetches an exception group's daily trend and its
+// latest occurrence for the stack trace
+func fetchExceptionDetail(c client.PostHogClient, key, group string, days int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		trend, err := c.GetExceptionTrend(ctx, group, days)
+		if err != nil {
+			return exceptionDetailMsg{key: key, err: err}
+		}
+		latest, err := c.GetLatestException(ctx, group)
+		return exceptionDetailMsg{key: key, trend: trend, latest: latest, err: err}
+	}
+}
+
+// exceptionDetailKey identifies an exception group's detail in a window
+func exceptionDetailKey(group string, days int) string {
+	return fmt.Sprintf("%d:%s", days, group)
+}
+
+// loadExceptionDetail returns a command fetching the inspected exception
+// group's detail in the current window, or nil if already loaded
+func (m *Model) loadExceptionDetail(group client.ExceptionGroup) tea.Cmd {
+	key := exceptionDetailKey(group.Key, m.exceptionDays)
+	if _, loaded := m.exceptionDetails[key]; loaded {
+		return nil
+	}
+	m.exceptionDetails[key] = exceptionDetail{loading: true}
+	return fetchExceptionDetail(m.client, key, group.Key, m.exceptionDays)
+}
+
+// cycleExceptionDays switches to the next window
+func (m *Model) cycleExceptionDays() {
+	next := 0
+	for i, days := range exceptionWindows {
+		if days == m.exceptionDays {
+			next = (i + 1) % len(exceptionWindows)
+			break
+		}
+	}
+	m.exceptionDays = exceptionWindows[next]
+}
+
+// tailSelectedException switches to the live Events list, filtered to the
+// inspected exception group's occurrences
+func (m *Model) tailSelectedException() tea.Cmd {
+	group, ok := m.inspectorData.(client.ExceptionGroup)
+	if !ok {
+		return nil
+	}
+	return m.tailEvents(exceptionTitle(group), client.ExceptionGroupCondition(group.Key))
+}
+
+// exceptionTitle formats an exception group as "Type: message"
+func exceptionTitle(group client.ExceptionGroup) string {
+	if group.Message == "" {
+		return group.Type
+	}
+	return group.Type + ": " + group.Message
+}
+
+// ExceptionListItem wraps a client.ExceptionGroup for list display
+type ExceptionListItem struct {
+	Group client.ExceptionGroup
+}
+
+func (e ExceptionListItem) RenderLine(width int, selected bool) string {
+	title := exceptionTitle(e.Group)
+	if len(title) > maxExceptionTitleLen {
+		title = styles.TruncateString(title, maxExceptionTitleLen)
+	}
+
+	line := fmt.Sprintf("%s %s %s %s",
+		styles.WarningTextStyle.Render(fmt.Sprintf("%5s×", components.FormatCompactNumber(float64(e.Group.Occurrences)))),
+		styles.DimTextStyle.Render(fmt.Sprintf("%4s users", components.FormatCompactNumber(float64(e.Group.Users)))),
+		title,
+		styles.DimTextStyle.Render(utils.FormatDuration(time.Since(e.Group.LastSeen))+" ago"))
+
+	if selected {
+		line = styles.SelectedListItemStyle.Render("▶ " + line)
+	} else {
+		line = styles.ListItemStyle.Render("  " + line)
+	}
+
+	return line
+}
+
+func (e ExceptionListItem) GetID() string {
+	return e.Group.Key
+}
+
+func (e ExceptionListItem) GetInspectorData() interface{} {
+	return e.Group
+}
+
+func (e ExceptionListItem) GetDistinctID() string {
+	return "" // Exception groups span many users
+}
+
+func (e ExceptionListItem) GetSearchableText() string {
+	return e.Group.Type + " " + e.Group.Message + " " + e.Group.Key
+}
+
+// renderExceptionInspectorScrollable renders an exception group's counts,
+// daily occurrences and affected users, and the stack trace of its latest
+// occurrence
+func (m Model) renderExceptionInspectorScrollable(width, height int) string {
+	group, ok := m.inspectorData.(client.ExceptionGroup)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid exception data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.ErrorTextStyle.Render(group.Type))
+	if group.Message != "" {
+		lines = append(lines, group.Message)
+	}
+	lines = append(lines, "")
+	if group.Key != exceptionTitle(group) {
+		lines = append(lines, styles.JSONKeyStyle.Render("Fingerprint: ")+group.Key)
+	}
+	lines = append(lines, styles.JSONKeyStyle.Render(fmt.Sprintf("Occurrences (%dd): ", m.exceptionDays))+fmt.Sprintf("%d", group.Occurrences))
+	lines = append(lines, styles.JSONKeyStyle.Render("Users affected: ")+fmt.Sprintf("%d", group.Users))
+	lines = append(lines, styles.JSONKeyStyle.Render("First seen: ")+client.FormatEventTime(group.FirstSeen.Local())+
+		styles.DimTextStyle.Render(" ("+utils.FormatDuration(time.Since(group.FirstSeen))+" ago)"))
+	lines = append(lines, styles.JSONKeyStyle.Render("Last seen: ")+client.FormatEventTime(group.LastSeen.Local())+
+		styles.DimTextStyle.Render(" ("+utils.FormatDuration(time.Since(group.LastSeen))+" ago)"))
+	lines = append(lines, "")
+
+	detail, loaded := m.exceptionDetails[exceptionDetailKey(group.Key, m.exceptionDays)]
+	switch {
+	case !loaded || detail.loading:
+		lines = append(lines, m.spinner.View()+" Loading occurrences...")
+	case detail.err != nil:
+		lines = append(lines, styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", detail.err)))
+	default:
+		occurrences, users := exceptionDailyValues(detail.trend, m.exceptionDays, time.Now())
+		sparkWidth := styles.Max(10, styles.Min(m.exceptionDays, width-30))
+		lines = append(lines, styles.JSONKeyStyle.Render("Per day, last "+fmt.Sprintf("%dd", m.exceptionDays)))
+		lines = append(lines, fmt.Sprintf("  %-12s %s", "Occurrences", components.Sparkline(occurrences, sparkWidth)))
+		lines = append(lines, fmt.Sprintf("  %-12s %s", "Users", components.Sparkline(users, sparkWidth)))
+		lines = append(lines, "")
+
+		if detail.latest == nil {
+			lines = append(lines, styles.DimTextStyle.Render("No occurrences in this window"))
+			break
+		}
+		lines = append(lines, styles.JSONKeyStyle.Render("Latest occurrence: ")+client.FormatEventTime(detail.latest.Timestamp.Local())+
+			styles.DimTextStyle.Render(" "+detail.latest.DistinctID))
+		if url := utils.EventURL(*detail.latest); url != "" {
+			lines = append(lines, styles.JSONKeyStyle.Render("URL: ")+url)
+		}
+		lines = append(lines, "")
+		lines = append(lines, renderStackTrace(utils.ParseExceptionList(detail.latest.Properties), width-8)...)
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.DimTextStyle.Render("Press L to tail live occurrences, d to change the window"))
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// exceptionDailyValues aligns an exception trend to the days of a window
+// ending now, filling days without occurrences with zero
+func exceptionDailyValues(trend []client.ExceptionTrendPoint, days int, now time.Time) (occurrences, users []float64) {
+	// Days are bucketed in the project's timezone, as returned by the query
+	location := time.UTC
+	if len(trend) > 0 {
+		location = trend[len(trend)-1].Day.Location()
+	}
+
+	byDay := make(map[string]client.ExceptionTrendPoint, len(trend))
+	for _, point := range trend {
+		byDay[point.Day.Format("2006-01-02")] = point
+	}
+
+	occurrences = make([]float64, days)
+	users = make([]float64, days)
+	today := now.In(location)
+	for i := range occurrences {
+		day := today.AddDate(0, 0, i-days+1).Format("2006-01-02")
+		occurrences[i] = float64(byDay[day].Occurrences)
+		users[i] = float64(byDay[day].Users)
+	}
+	return occurrences, users
+}
+
+// renderStackTrace renders exceptions as readable stack traces, most recent
+// call first, with the application's own frames highlighted
+func renderStackTrace(entries []utils.ExceptionEntry, width int) []string {
+	if len(entries) == 0 {
+		return []string{styles.DimTextStyle.Render("No exception details captured")}
+	}
+
+	var lines []string
+	for i, entry := range entries {
+		if i > 0 {
+			lines = append(lines, "", styles.DimTextStyle.Render("Caused by"))
+		}
+		lines = append(lines, styles.ErrorTextStyle.Render(entry.Type)+": "+entry.Value)
+
+		if len(entry.Frames) == 0 {
+			lines = append(lines, styles.DimTextStyle.Render("  (no stack frames)"))
+			continue
+		}
+		for j := len(entry.Frames) - 1; j >= 0; j-- {
+			frame := entry.Frames[j]
+			function := frame.Function
+			if function == "" {
+				function = "<anonymous>"
+			}
+			line := styles.TruncateString(fmt.Sprintf("  %s  %s", frame.Location(), function), width)
+			if frame.InApp {
+				lines = append(lines, styles.HighlightTextStyle.Render(line))
+			} else {
+				lines = append(lines, styles.DimTextStyle.Render(line))
+			}
+		}
+	}
+
+	lines = append(lines, "", styles.DimTextStyle.Render("Most recent call first; highlighted frames are in-app"))
+	return lines
+}
@@ -0,0 +1,235 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/components"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxExperimentNameLen is the truncation length of experiment names in the list
+const maxExperimentNameLen = 30
+
+// experimentResult holds the fetched results of a single experiment
+type experimentResult struct {
+	results *client.ExperimentResults
+	loading bool
+	err     error
+}
+
+// experimentsMsg is sent when the experiments list has been fetched
+type experimentsMsg []client.Experiment
+
+// experimentResultsMsg is sent when an experiment's results have been fetched
+type experimentResultsMsg struct {
+	experimentID int
+	results      *client.ExperimentResults
+	err          error
+}
+
+func fetchExperiments(c client.PostHogClient) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		experiments, err := c.ListExperiments(ctx)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		return experimentsMsg(experiments)
+	}
+}
+
+// fetchExperimentResults fetches the current results of an experiment
+func fetchExperimentResults(c client.PostHogClient, experimentID int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		results, err := c.GetExperimentResults(ctx, experimentID)
+		return experimentResultsMsg{experimentID: experimentID, results: results, err: err}
+	}
+}
+
+// ExperimentListItem wraps a client.Experiment for list display
+type ExperimentListItem struct {
+	Experiment client.Experiment
+}
+
+func (e ExperimentListItem) RenderLine(width int, selected bool) string {
+	name := e.Experiment.Name
+	if len(name) > maxExperimentNameLen {
+		name = styles.TruncateString(name, maxExperimentNameLen)
+	}
+
+	flagKey := e.Experiment.FeatureFlagKey
+	if len(flagKey) > maxFlagKeyLen {
+		flagKey = styles.TruncateString(flagKey, maxFlagKeyLen)
+	}
+
+	line := fmt.Sprintf("%s %s %s", experimentStatusIcon(e.Experiment.Status()), name, styles.DimTextStyle.Render(flagKey))
+
+	if selected {
+		line = styles.SelectedListItemStyle.Render("▶ " + line)
+	} else {
+		line = styles.ListItemStyle.Render("  " + line)
+	}
+
+	return line
+}
+
+func (e ExperimentListItem) GetID() string {
+	return fmt.Sprintf("%d", e.Experiment.ID)
+}
+
+func (e ExperimentListItem) GetInspectorData() interface{} {
+	return e.Experiment
+}
+
+func (e ExperimentListItem) GetDistinctID() string {
+	return "" // Experiments don't have distinct IDs
+}
+
+func (e ExperimentListItem) GetSearchableText() string {
+	return e.Experiment.Name + " " + e.Experiment.FeatureFlagKey + " " + string(e.Experiment.Status())
+}
+
+// experimentStatusIcon returns a status indicator for an experiment
+func experimentStatusIcon(status client.ExperimentStatus) string {
+	switch status {
+	case client.ExperimentRunning:
+		return styles.SuccessTextStyle.Render("●")
+	case client.ExperimentComplete:
+		return styles.HighlightTextStyle.Render("✓")
+	default:
+		return styles.DimTextStyle.Render("○")
+	}
+}
+
+// renderExperimentInspectorScrollable renders experiment details and results
+func (m Model) renderExperimentInspectorScrollable(width, height int) string {
+	exp, ok := m.inspectorData.(client.Experiment)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid experiment data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Name: ")+exp.Name)
+	lines = append(lines, styles.JSONKeyStyle.Render("Status: ")+experimentStatusIcon(exp.Status())+" "+string(exp.Status()))
+	lines = append(lines, styles.JSONKeyStyle.Render("Feature flag: ")+exp.FeatureFlagKey)
+	if exp.StartDate != nil {
+		lines = append(lines, styles.JSONKeyStyle.Render("Started: ")+client.FormatEventTime(exp.StartDate.Local()))
+	}
+	if exp.EndDate != nil {
+		lines = append(lines, styles.JSONKeyStyle.Render("Ended: ")+client.FormatEventTime(exp.EndDate.Local()))
+	}
+	if exp.Description != "" {
+		lines = append(lines, "")
+		lines = append(lines, exp.Description)
+	}
+	lines = append(lines, "")
+
+	// Variants
+	lines = append(lines, styles.JSONKeyStyle.Render("Variants:"))
+	if len(exp.Parameters.FeatureFlagVariants) == 0 {
+		lines = append(lines, styles.DimTextStyle.Render("  (none)"))
+	}
+	for _, v := range exp.Parameters.FeatureFlagVariants {
+		lines = append(lines, fmt.Sprintf("  %-20s %3.0f%%", v.Key, v.RolloutPercentage))
+	}
+	lines = append(lines, "")
+
+	// Primary metric
+	metric := exp.PrimaryMetric()
+	if metric == "" {
+		metric = styles.DimTextStyle.Render("(not set)")
+	}
+	lines = append(lines, styles.JSONKeyStyle.Render("Primary metric: ")+metric)
+	lines = append(lines, "")
+
+	// Results
+	lines = append(lines, styles.JSONKeyStyle.Render("Results:"))
+	lines = append(lines, m.renderExperimentResults(exp)...)
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// renderExperimentResults renders win probabilities and significance per variant
+func (m Model) renderExperimentResults(exp client.Experiment) []string {
+	if exp.Status() == client.ExperimentDraft {
+		return []string{styles.DimTextStyle.Render("  Experiment has not started")}
+	}
+
+	result, loaded := m.experimentResults[exp.ID]
+	switch {
+	case !loaded || result.loading:
+		return []string{"  " + m.spinner.View() + " Loading results..."}
+	case result.err != nil:
+		return []string{styles.ErrorTextStyle.Render(fmt.Sprintf("  Error: %v", result.err))}
+	}
+
+	r := result.results
+	var lines []string
+
+	significance := styles.DimTextStyle.Render("not significant")
+	if r.Significant {
+		significance = styles.SuccessTextStyle.Render("significant")
+	}
+	if r.SignificanceCode != "" {
+		significance += styles.DimTextStyle.Render(" (" + r.SignificanceCode + ")")
+	}
+	lines = append(lines, "  Significance: "+significance)
+	if r.PValue > 0 {
+		lines = append(lines, fmt.Sprintf("  p-value: %.4f", r.PValue))
+	}
+	if r.ExpectedLoss > 0 {
+		lines = append(lines, fmt.Sprintf("  Expected loss: %.4f", r.ExpectedLoss))
+	}
+	lines = append(lines, "")
+
+	// Win probability per variant, in a stable order
+	keys := make([]string, 0, len(r.Probability))
+	for key := range r.Probability {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if len(keys) > 0 {
+		lines = append(lines, "  Win probability:")
+	}
+	for _, key := range keys {
+		p := r.Probability[key]
+		line := fmt.Sprintf("  %-14s %s %5.1f%%", styles.TruncateString(key, 14), components.RenderBar(p, 20), p*100)
+		if interval := r.CredibleIntervals[key]; len(interval) == 2 {
+			line += styles.DimTextStyle.Render(fmt.Sprintf("  [%.3f, %.3f]", interval[0], interval[1]))
+		}
+		lines = append(lines, line)
+	}
+
+	// Per-variant counts/exposure as returned by the API
+	if len(r.Variants) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, "  Variants:")
+		lines = append(lines, m.renderFoldedJSON(r.Variants, 0)...)
+	}
+
+	if r.LastRefresh != "" {
+		lines = append(lines, "")
+		lines = append(lines, styles.CaptionStyle.Render("  Last refreshed "+r.LastRefresh))
+	}
+
+	return lines
+}
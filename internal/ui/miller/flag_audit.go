@@ -0,0 +1,190 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// flagAuditWindows are the evaluation windows (in days) cycled with 'd'
+var flagAuditWindows = []int{7, 30, 90}
+
+// flagAuditMsg is sent when the flag audit report has been built
+type flagAuditMsg []utils.FlagAuditRow
+
+// fetchFlagAudit lists all flags and combines them with their usage over the window
+func fetchFlagAudit(c client.PostHogClient, days int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		flags, err := c.ListFlags(ctx)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+
+		usage, err := c.GetFlagUsage(ctx, days)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+
+		return flagAuditMsg(utils.BuildFlagAudit(flags, usage, days, time.Now()))
+	}
+}
+
+// FlagAuditListItem wraps a utils.FlagAuditRow for list display
+type FlagAuditListItem struct {
+	Row utils.FlagAuditRow
+}
+
+func (f FlagAuditListItem) RenderLine(width int, selected bool) string {
+	key := f.Row.Flag.Key
+	if len(key) > maxFlagKeyLen {
+		key = styles.TruncateString(key, maxFlagKeyLen)
+	}
+
+	marker := " "
+	if f.Row.Candidate {
+		marker = styles.WarningTextStyle.Render("⚠")
+	}
+
+	stats := fmt.Sprintf("%-7s %6d %s", f.Row.Rollout, f.Row.Evaluations, f.Row.FormatLastEvaluated(time.Now()))
+	line := fmt.Sprintf("%s %s %s", marker, key, styles.DimTextStyle.Render(stats))
+
+	if selected {
+		line = styles.SelectedListItemStyle.Render("▶ " + line)
+	} else {
+		line = styles.ListItemStyle.Render("  " + line)
+	}
+
+	return line
+}
+
+func (f FlagAuditListItem) GetID() string {
+	return fmt.Sprintf("%d", f.Row.Flag.ID)
+}
+
+func (f FlagAuditListItem) GetInspectorData() interface{} {
+	return f.Row
+}
+
+func (f FlagAuditListItem) GetDistinctID() string {
+	return "" // Flags don't have distinct IDs
+}
+
+func (f FlagAuditListItem) GetSearchableText() string {
+	return f.Row.Flag.Key + " " + f.Row.Flag.Name + " " + strings.Join(f.Row.Reasons, " ")
+}
+
+// setFlagAuditListItems rebuilds the list from the (sorted) audit rows,
+// re-applying the active search filter
+func (m *Model) setFlagAuditListItems() {
+	m.listItems = make([]ListItem, len(m.flagAuditRows))
+	for i, row := range m.flagAuditRows {
+		m.listItems[i] = FlagAuditListItem{Row: row}
+	}
+
+	if m.filteredItems != nil {
+		m.filteredItems = m.applyFilter(m.listItems, m.searchInput.Value())
+	}
+}
+
+// cycleFlagAuditSort sorts the audit by the next column
+func (m *Model) cycleFlagAuditSort() {
+	m.flagAuditSort = m.flagAuditSort.Next()
+	utils.SortFlagAudit(m.flagAuditRows, m.flagAuditSort)
+	m.setFlagAuditListItems()
+	m.listCursor = 0
+	m.updateInspectorFromCursor()
+}
+
+// cycleFlagAuditDays switches to the next evaluation window
+func (m *Model) cycleFlagAuditDays() {
+	next := 0
+	for i, days := range flagAuditWindows {
+		if days == m.flagAuditDays {
+			next = (i + 1) % len(flagAuditWindows)
+			break
+		}
+	}
+	m.flagAuditDays = flagAuditWindows[next]
+}
+
+// exportFlagAudit writes the current report to a CSV file in the working directory
+func (m *Model) exportFlagAudit() {
+	if len(m.flagAuditRows) == 0 {
+		return
+	}
+
+	filename := fmt.Sprintf("flag-audit-%s.csv", time.Now().Format("20060102-150405"))
+	if err := utils.ExportFlagAuditCSV(m.flagAuditRows, filename); err != nil {
+		m.showClipboardFeedback("Export failed")
+		return
+	}
+	m.showClipboardFeedback("Exported " + filename)
+}
+
+// flagAuditTitle returns the list title including the window and sort column
+func (m Model) flagAuditTitle() string {
+	return fmt.Sprintf("%s (%dd, by %s)", ResourceFlagAudit.String(), m.flagAuditDays, m.flagAuditSort)
+}
+
+// renderFlagAuditInspectorScrollable renders the audit details of a flag
+func (m Model) renderFlagAuditInspectorScrollable(width, height int) string {
+	row, ok := m.inspectorData.(utils.FlagAuditRow)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid flag audit data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Key: ")+row.Flag.Key)
+	if row.Flag.Name != "" {
+		lines = append(lines, styles.JSONKeyStyle.Render("Name: ")+row.Flag.Name)
+	}
+	lines = append(lines, "")
+
+	// Verdict
+	if row.Candidate {
+		lines = append(lines, styles.WarningTextStyle.Render("⚠ Removal candidate"))
+		for _, reason := range row.Reasons {
+			lines = append(lines, fmt.Sprintf("  • %s", reason))
+		}
+	} else {
+		lines = append(lines, styles.SuccessTextStyle.Render("✓ In use"))
+	}
+	lines = append(lines, "")
+
+	// Usage
+	lines = append(lines, styles.JSONKeyStyle.Render("Rollout: ")+string(row.Rollout))
+	lines = append(lines, styles.JSONKeyStyle.Render(fmt.Sprintf("Evaluations (%dd): ", m.flagAuditDays))+fmt.Sprintf("%d", row.Evaluations))
+	lastEvaluated := row.FormatLastEvaluated(time.Now())
+	if !row.LastEvaluated.IsZero() {
+		lastEvaluated += " (" + client.FormatEventTime(row.LastEvaluated.Local()) + ")"
+	}
+	lines = append(lines, styles.JSONKeyStyle.Render("Last evaluated: ")+lastEvaluated)
+	if row.Flag.CreatedAt != "" {
+		lines = append(lines, styles.JSONKeyStyle.Render("Created: ")+row.Flag.CreatedAt+" ("+row.FormatAge()+" ago)")
+	}
+	lines = append(lines, "")
+
+	// Filters (if available)
+	if len(row.Flag.Filters) > 0 {
+		lines = append(lines, styles.JSONKeyStyle.Render("Filters:"))
+		lines = append(lines, m.renderFoldedJSON(row.Flag.Filters, 0)...)
+	}
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
@@ -0,0 +1,96 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// flagHistory holds the activity log of a single feature flag
+type flagHistory struct {
+	entries []client.ActivityLogEntry
+	loading bool
+	err     error
+}
+
+// flagActivityMsg is sent when a flag's activity log has been fetched
+type flagActivityMsg struct {
+	flagID  int
+	entries []client.ActivityLogEntry
+	err     error
+}
+
+// fetchFlagActivity fetches the change history of a feature flag
+func fetchFlagActivity(c client.PostHogClient, flagID int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		entries, err := c.GetFlagActivity(ctx, flagID)
+		return flagActivityMsg{flagID: flagID, entries: entries, err: err}
+	}
+}
+
+// renderFlagHistoryScrollable renders a timeline of changes to the selected flag
+func (m Model) renderFlagHistoryScrollable(width, height int) string {
+	flag, ok := m.inspectorData.(client.FeatureFlag)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid flag data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Key: ")+flag.Key)
+	lines = append(lines, "")
+
+	history, loaded := m.flagHistories[flag.ID]
+	switch {
+	case !loaded || history.loading:
+		lines = append(lines, m.spinner.View()+" Loading history...")
+	case history.err != nil:
+		lines = append(lines, styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", history.err)))
+	case len(history.entries) == 0:
+		lines = append(lines, styles.DimTextStyle.Render("No changes recorded"))
+	default:
+		for _, entry := range history.entries {
+			lines = append(lines, renderActivityEntry(entry)...)
+			lines = append(lines, "")
+		}
+	}
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// renderActivityEntry renders one activity log entry as a timeline node
+func renderActivityEntry(entry client.ActivityLogEntry) []string {
+	header := fmt.Sprintf("● %s  %s  %s",
+		styles.DimTextStyle.Render(client.FormatEventTime(entry.CreatedAt.Local())),
+		entry.User.DisplayName(),
+		styles.HighlightTextStyle.Render(entry.Activity),
+	)
+	lines := []string{header}
+
+	for _, change := range entry.Detail.Changes {
+		for _, diff := range utils.DiffJSON(change.Field, change.Before, change.After) {
+			lines = append(lines, fmt.Sprintf("│   %s %s → %s",
+				styles.JSONKeyStyle.Render(diff.Path+":"),
+				styles.DimTextStyle.Render(utils.FormatJSONValue(diff.Before)),
+				styles.SuccessTextStyle.Render(utils.FormatJSONValue(diff.After)),
+			))
+		}
+	}
+
+	return lines
+}
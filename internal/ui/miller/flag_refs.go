@@ -0,0 +1,97 @@
+package miller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// flagRefsMsg is sent when the local repository scan has finished
+type flagRefsMsg struct {
+	refs map[string][]utils.CodeReference
+	err  error
+}
+
+// scanFlagRefs scans the local repository for references to every flag key
+func scanFlagRefs(repoPath string, keys []string) tea.Cmd {
+	return func() tea.Msg {
+		refs, err := utils.ScanFlagReferences(repoPath, keys)
+		return flagRefsMsg{refs: refs, err: err}
+	}
+}
+
+// flagKeys returns the keys of all flags in the list
+func (m Model) flagKeys() []string {
+	var keys []string
+	for _, item := range m.listItems {
+		if f, ok := item.(FlagListItem); ok {
+			keys = append(keys, f.Flag.Key)
+		}
+	}
+	return keys
+}
+
+// renderFlagRefsScrollable renders the code references to the selected flag
+func (m Model) renderFlagRefsScrollable(width, height int) string {
+	flag, ok := m.inspectorData.(client.FeatureFlag)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid flag data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Key: ")+flag.Key)
+	lines = append(lines, "")
+
+	refs, scanned := m.flagRefs[flag.Key]
+	switch {
+	case m.repoPath == "":
+		lines = append(lines, styles.DimTextStyle.Render("No repository configured"))
+		lines = append(lines, "")
+		lines = append(lines, styles.CaptionStyle.Render("Run 'lazyhog --repo ./path' or set repo_path in the config"))
+		lines = append(lines, styles.CaptionStyle.Render("to scan a local source tree for flag keys"))
+	case m.flagRefsLoading:
+		lines = append(lines, m.spinner.View()+" Scanning "+m.repoPath+"...")
+	case m.flagRefsErr != nil:
+		lines = append(lines, styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", m.flagRefsErr)))
+	case !scanned:
+		lines = append(lines, styles.DimTextStyle.Render("Not scanned yet"))
+	default:
+		lines = append(lines, styles.JSONKeyStyle.Render("Repository: ")+m.repoPath)
+		lines = append(lines, "")
+
+		if len(refs) == 0 {
+			lines = append(lines, styles.WarningTextStyle.Render("⚠ No references found"))
+			lines = append(lines, styles.CaptionStyle.Render("This flag may be safe to remove"))
+		} else {
+			lines = append(lines, styles.JSONKeyStyle.Render(fmt.Sprintf("References (%d):", len(refs))))
+			for _, ref := range refs {
+				lines = append(lines, styles.HighlightTextStyle.Render(fmt.Sprintf("%s:%d", ref.File, ref.Line)))
+				lines = append(lines, "  "+styles.DimTextStyle.Render(ref.Text))
+			}
+		}
+
+		// Summary of unreferenced flags across the whole project
+		unreferenced := 0
+		for _, keyRefs := range m.flagRefs {
+			if len(keyRefs) == 0 {
+				unreferenced++
+			}
+		}
+		lines = append(lines, "")
+		lines = append(lines, styles.DimTextStyle.Render(
+			fmt.Sprintf("%d of %d flags have no references", unreferenced, len(m.flagRefs))))
+	}
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
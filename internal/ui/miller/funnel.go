@@ -0,0 +1,330 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	minFunnelSteps = 2
+	maxFunnelSteps = 5
+)
+
+// funnelDateRanges are the date ranges cycled through in funnel mode
+var funnelDateRanges = []struct {
+	label    string
+	dateFrom string
+}{
+	{label: "7d", dateFrom: "-7d"},
+	{label: "30d", dateFrom: "-30d"},
+	{label: "90d", dateFrom: "-90d"},
+	{label: "24h", dateFrom: "-24h"},
+}
+
+// funnelWindows are the conversion windows cycled through in funnel mode
+var funnelWindows = []client.FunnelWindow{
+	{Interval: 14, Unit: "day"},
+	{Interval: 1, Unit: "hour"},
+	{Interval: 1, Unit: "day"},
+	{Interval: 7, Unit: "day"},
+	{Interval: 30, Unit: "day"},
+}
+
+// funnelView is the funnel being built and its last result. It is kept
+// after the overlay is closed so steps can be added from the event list.
+type funnelView struct {
+	events     []string
+	dateRange  int // index into funnelDateRanges
+	window     int // index into funnelWindows
+	result     []client.FunnelStep
+	hasResult  bool
+	loading    bool
+	err        error
+	seq        int  // identifies the latest request, to drop stale responses
+	pickerWait bool // open the event picker once event definitions arrive
+}
+
+// funnelMsg is sent when a funnel has been run
+type funnelMsg struct {
+	seq   int
+	steps []client.FunnelStep
+	err   error
+}
+
+// eventNamesMsg is sent when event definitions have been fetched
+type eventNamesMsg struct {
+	names []string
+	err   error
+}
+
+// fetchFunnel runs a funnel over the given events
+func fetchFunnel(c client.PostHogClient, seq int, events []string, dateFrom string, window client.FunnelWindow) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		steps, err := c.RunFunnel(ctx, events, dateFrom, window)
+		return funnelMsg{seq: seq, steps: steps, err: err}
+	}
+}
+
+// fetchEventNames fetches the names of all event definitions
+func fetchEventNames(c client.PostHogClient) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		definitions, err := c.ListEventDefinitions(ctx)
+		if err != nil {
+			return eventNamesMsg{err: err}
+		}
+
+		names := make([]string, len(definitions))
+		for i, definition := range definitions {
+			names[i] = definition.Name
+		}
+		sort.Strings(names)
+		return eventNamesMsg{names: names}
+	}
+}
+
+// addFunnelStep adds the selected event as the next funnel step and opens
+// funnel mode
+func (m *Model) addFunnelStep() {
+	if m.funnel == nil {
+		m.funnel = &funnelView{}
+	}
+	m.funnelOpen = true
+
+	event, ok := m.inspectorData.(client.Event)
+	if !ok {
+		return
+	}
+	m.appendFunnelEvent(event.Event)
+}
+
+// appendFunnelEvent adds an event to the end of the funnel
+func (m *Model) appendFunnelEvent(event string) {
+	f := m.funnel
+	if len(f.events) >= maxFunnelSteps {
+		m.showClipboardFeedback(fmt.Sprintf("A funnel has at most %d steps", maxFunnelSteps))
+		return
+	}
+	f.events = append(f.events, event)
+	f.hasResult = false
+	f.err = nil
+}
+
+// openFunnelPicker opens a fuzzy picker over event definitions, fetching
+// them first if needed
+func (m *Model) openFunnelPicker() tea.Cmd {
+	if m.eventNames == nil {
+		m.funnel.pickerWait = true
+		return fetchEventNames(m.client)
+	}
+
+	m.showFunnelPicker(m.eventNames)
+	return nil
+}
+
+// showFunnelPicker opens the picker of the next funnel step over names
+func (m *Model) showFunnelPicker(names []string) {
+	m.openPicker(fmt.Sprintf("Funnel step %d", len(m.funnel.events)+1), names, func(m *Model, event string) tea.Cmd {
+		if m.funnel != nil {
+			m.appendFunnelEvent(event)
+		}
+		return nil
+	})
+}
+
+// applyEventNames stores fetched event names and opens a pending picker.
+// If definitions can't be fetched, names from the live list are offered
+// without being stored, so that definitions are fetched again next time.
+func (m *Model) applyEventNames(msg eventNamesMsg) tea.Cmd {
+	names := msg.names
+	if msg.err != nil {
+		m.showClipboardFeedback("Event definitions failed, using live events")
+		names = m.liveEventNames()
+	} else {
+		m.eventNames = msg.names
+	}
+
+	if m.funnel == nil || !m.funnel.pickerWait {
+		return nil
+	}
+	m.funnel.pickerWait = false
+	if !m.funnelOpen {
+		return nil
+	}
+	m.showFunnelPicker(names)
+	return nil
+}
+
+// liveEventNames returns the distinct event names in the live event list
+func (m Model) liveEventNames() []string {
+	seen := make(map[string]bool)
+	names := []string{}
+	for _, item := range m.listItems {
+		event, ok := item.(EventListItem)
+		if ok && !seen[event.Event.Event] {
+			seen[event.Event.Event] = true
+			names = append(names, event.Event.Event)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// runFunnel runs the funnel with its current steps and settings
+func (m *Model) runFunnel() tea.Cmd {
+	f := m.funnel
+	if len(f.events) < minFunnelSteps {
+		m.showClipboardFeedback(fmt.Sprintf("Add at least %d steps", minFunnelSteps))
+		return nil
+	}
+
+	f.seq++
+	f.loading = true
+	f.err = nil
+	return fetchFunnel(m.client, f.seq, f.events, funnelDateRanges[f.dateRange].dateFrom, funnelWindows[f.window])
+}
+
+// applyFunnel stores a funnel result, ignoring stale responses
+func (m *Model) applyFunnel(msg funnelMsg) {
+	if m.funnel == nil || msg.seq != m.funnel.seq {
+		return
+	}
+	m.funnel.loading = false
+	m.funnel.result = msg.steps
+	m.funnel.err = msg.err
+	m.funnel.hasResult = msg.err == nil
+}
+
+// handleFunnelKeys handles keyboard input while funnel mode is shown
+func (m Model) handleFunnelKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	f := m.funnel
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc", "q":
+		m.funnelOpen = false
+		return m, nil
+
+	case "a":
+		if len(f.events) >= maxFunnelSteps {
+			m.showClipboardFeedback(fmt.Sprintf("A funnel has at most %d steps", maxFunnelSteps))
+			return m, nil
+		}
+		return m, m.openFunnelPicker()
+
+	case "x", "backspace":
+		// Remove the last step
+		if len(f.events) > 0 {
+			f.events = f.events[:len(f.events)-1]
+			f.hasResult = false
+			f.err = nil
+		}
+		return m, nil
+
+	case "X":
+		// Start over
+		m.funnel = &funnelView{dateRange: f.dateRange, window: f.window}
+		return m, nil
+
+	case "w":
+		f.dateRange = (f.dateRange + 1) % len(funnelDateRanges)
+		if f.hasResult {
+			return m, m.runFunnel()
+		}
+		return m, nil
+
+	case "c":
+		f.window = (f.window + 1) % len(funnelWindows)
+		if f.hasResult {
+			return m, m.runFunnel()
+		}
+		return m, nil
+
+	case "enter", "r":
+		return m, m.runFunnel()
+	}
+
+	return m, nil
+}
+
+// renderFunnelOverlay renders funnel mode: the steps, settings and result
+func (m Model) renderFunnelOverlay(width, height int) string {
+	f := m.funnel
+	var sb strings.Builder
+
+	title := "Funnel"
+	if !m.clipboardTime.IsZero() && time.Since(m.clipboardTime) < 2*time.Second {
+		title += " - " + m.clipboardMsg
+	}
+	sb.WriteString(styles.TitleStyle.Render(title))
+	sb.WriteString("\n")
+	sb.WriteString(styles.DimTextStyle.Render(fmt.Sprintf("last %s · %s conversion window",
+		funnelDateRanges[f.dateRange].label, funnelWindows[f.window])))
+	sb.WriteString("\n\n")
+
+	// Steps
+	if len(f.events) == 0 {
+		sb.WriteString(styles.DimTextStyle.Render("No steps yet. Press a to pick an event, or f on an event in the list."))
+		sb.WriteString("\n")
+	}
+	for i, event := range f.events {
+		sb.WriteString(fmt.Sprintf("%s %s\n", styles.KeyStyle.Render(fmt.Sprintf("%d.", i+1)), event))
+	}
+	sb.WriteString("\n")
+
+	// Result
+	chartWidth := width - 12
+	switch {
+	case f.pickerWait:
+		sb.WriteString(m.spinner.View() + " Loading event definitions...")
+		sb.WriteString("\n")
+	case f.loading:
+		sb.WriteString(m.spinner.View() + " Running funnel...")
+		sb.WriteString("\n")
+	case f.err != nil:
+		sb.WriteString(styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", f.err)))
+		sb.WriteString("\n")
+	case f.hasResult:
+		sb.WriteString(strings.Join(renderFunnelBars(f.result, chartWidth), "\n"))
+		sb.WriteString("\n")
+	case len(f.events) >= minFunnelSteps:
+		sb.WriteString(styles.DimTextStyle.Render("Press Enter to run the funnel"))
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+
+	hints := []string{
+		styles.KeyStyle.Render("a") + " add step",
+		styles.KeyStyle.Render("x") + " remove last",
+		styles.KeyStyle.Render("X") + " clear",
+		styles.KeyStyle.Render("w") + " date range",
+		styles.KeyStyle.Render("c") + " conversion window",
+		styles.KeyStyle.Render("Enter") + " run",
+		styles.KeyStyle.Render("Esc") + " close",
+	}
+	sb.WriteString(strings.Join(hints, " • "))
+
+	overlayStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorPrimary).
+		Padding(1, 2).
+		Width(width - 4).
+		Height(height - 4)
+
+	return overlayStyle.Render(sb.String())
+}
@@ -0,0 +1,339 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// maxGroups is the number of groups listed per group type
+	maxGroups = 200
+	// maxGroupMembers is the number of most recently seen members shown
+	maxGroupMembers = 50
+	// maxGroupEvents is the number of most recent group events shown
+	maxGroupEvents = 30
+	// maxGroupNameLen is the truncation length of group names in the list
+	maxGroupNameLen = 35
+)
+
+// groupActivity holds a group's members and recent events
+type groupActivity struct {
+	members []client.GroupMember
+	events  []client.Event
+	loading bool
+	err     error
+}
+
+// groupsMsg is sent when the groups of the selected type have been fetched
+type groupsMsg struct {
+	groupTypes []client.GroupType
+	typePos    int // position of the listed type in groupTypes
+	groups     []client.Group
+}
+
+// groupActivityMsg is sent when a group's members and events have been fetched
+type groupActivityMsg struct {
+	key     string
+	members []client.GroupMember
+	events  []client.Event
+	err     error
+}
+
+// groupPivotMsg is sent when the group an event belongs to has been fetched
+type groupPivotMsg struct {
+	groupTypes []client.GroupType
+	typePos    int
+	group      *client.Group
+}
+
+// fetchGroups fetches the group types, then the groups of the type at typePos
+func fetchGroups(c client.PostHogClient, typePos int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		groupTypes, err := c.ListGroupTypes(ctx)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		if len(groupTypes) == 0 {
+			return groupsMsg{groupTypes: groupTypes}
+		}
+		if typePos >= len(groupTypes) {
+			typePos = 0
+		}
+
+		groups, err := c.ListGroups(ctx, groupTypes[typePos].Index, maxGroups)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		return groupsMsg{groupTypes: groupTypes, typePos: typePos, groups: groups}
+	}
+}
+
+// fetchGroupActivity fetches a group's members and recent events
+func fetchGroupActivity(c client.PostHogClient, group client.Group) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		key := groupActivityKey(group)
+		members, err := c.ListGroupMembers(ctx, group.TypeIndex, group.Key, maxGroupMembers)
+		if err != nil {
+			return groupActivityMsg{key: key, err: err}
+		}
+		events, err := c.ListGroupEvents(ctx, group.TypeIndex, group.Key, maxGroupEvents)
+		return groupActivityMsg{key: key, members: members, events: events, err: err}
+	}
+}
+
+// fetchGroupPivot fetches the group of the named type with the given key
+func fetchGroupPivot(c client.PostHogClient, groupType, key string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		groupTypes, err := c.ListGroupTypes(ctx)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		for i, t := range groupTypes {
+			if t.Type != groupType {
+				continue
+			}
+			group, err := c.GetGroup(ctx, t.Index, key)
+			if err != nil {
+				return errorMsg{err: err}
+			}
+			return groupPivotMsg{groupTypes: groupTypes, typePos: i, group: group}
+		}
+		return errorMsg{err: fmt.Errorf("group type not found: %s", groupType)}
+	}
+}
+
+// groupActivityKey identifies a group's activity
+func groupActivityKey(group client.Group) string {
+	return fmt.Sprintf("%d:%s", group.TypeIndex, group.Key)
+}
+
+// loadGroupActivity returns a command fetching the inspected group's members
+// and events, or nil if already loaded
+func (m *Model) loadGroupActivity(group client.Group) tea.Cmd {
+	key := groupActivityKey(group)
+	if _, loaded := m.groupActivity[key]; loaded {
+		return nil
+	}
+	m.groupActivity[key] = groupActivity{loading: true}
+	return fetchGroupActivity(m.client, group)
+}
+
+// cycleGroupType lists the groups of the next group type
+func (m *Model) cycleGroupType() tea.Cmd {
+	if len(m.groupTypes) < 2 {
+		return nil
+	}
+	m.groupTypePos = (m.groupTypePos + 1) % len(m.groupTypes)
+	m.loading = true
+	m.listCursor = 0
+	m.inspectorData = nil
+	return m.fetchCurrentResource()
+}
+
+// groupsTitle returns the Groups list title with the listed group type
+func (m Model) groupsTitle() string {
+	title := m.selectedResource.String()
+	if m.groupTypePos < len(m.groupTypes) {
+		title += " · " + m.groupTypes[m.groupTypePos].Plural()
+	}
+	return title
+}
+
+// eventGroups returns an event's groups as "type: key" labels, sorted by type
+func eventGroups(event client.Event) []string {
+	groups, _ := event.Properties["$groups"].(map[string]interface{})
+	labels := make([]string, 0, len(groups))
+	for groupType, key := range groups {
+		if key, ok := key.(string); ok && key != "" {
+			labels = append(labels, groupType+": "+key)
+		}
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// pivotToGroup shows the group the inspected event belongs to, asking which
+// one if it belongs to several
+func (m *Model) pivotToGroup() tea.Cmd {
+	event, ok := m.inspectorData.(client.Event)
+	if !ok {
+		return nil
+	}
+
+	pivot := func(m *Model, label string) tea.Cmd {
+		groupType, key, _ := strings.Cut(label, ": ")
+		return fetchGroupPivot(m.client, groupType, key)
+	}
+
+	labels := eventGroups(event)
+	switch len(labels) {
+	case 0:
+		m.showClipboardFeedback("Event has no groups")
+		return nil
+	case 1:
+		return pivot(m, labels[0])
+	default:
+		m.openPicker("Go to group", labels, pivot)
+		return nil
+	}
+}
+
+// GroupListItem wraps a client.Group for list display
+type GroupListItem struct {
+	Group client.Group
+}
+
+func (g GroupListItem) RenderLine(width int, selected bool) string {
+	name := g.Group.Name()
+	if len(name) > maxGroupNameLen {
+		name = styles.TruncateString(name, maxGroupNameLen)
+	}
+
+	line := name
+	if name != g.Group.Key {
+		line += " " + styles.DimTextStyle.Render(styles.TruncateString(g.Group.Key, maxDistinctIDLen))
+	}
+
+	if selected {
+		line = styles.SelectedListItemStyle.Render("▶ " + line)
+	} else {
+		line = styles.ListItemStyle.Render("  " + line)
+	}
+
+	return line
+}
+
+func (g GroupListItem) GetID() string {
+	return g.Group.Key
+}
+
+func (g GroupListItem) GetInspectorData() interface{} {
+	return g.Group
+}
+
+func (g GroupListItem) GetDistinctID() string {
+	return "" // Groups don't have distinct IDs
+}
+
+func (g GroupListItem) GetSearchableText() string {
+	return g.Group.Name() + " " + g.Group.Key
+}
+
+// renderGroupInspectorScrollable renders a group's properties
+func (m Model) renderGroupInspectorScrollable(width, height int) string {
+	group, ok := m.inspectorData.(client.Group)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid group data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Name: ")+group.Name())
+	lines = append(lines, styles.JSONKeyStyle.Render("Key: ")+group.Key)
+	for _, t := range m.groupTypes {
+		if t.Index == group.TypeIndex {
+			lines = append(lines, styles.JSONKeyStyle.Render("Type: ")+t.Type)
+		}
+	}
+	if group.CreatedAt != "" {
+		lines = append(lines, styles.JSONKeyStyle.Render("Created: ")+group.CreatedAt)
+	}
+	lines = append(lines, "")
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Properties:"))
+	if len(group.Properties) == 0 {
+		lines = append(lines, styles.DimTextStyle.Render("  (no properties)"))
+	} else {
+		lines = append(lines, m.renderFoldedJSON(group.Properties, 0)...)
+	}
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// renderGroupActivityScrollable renders a group's members or recent events
+func (m Model) renderGroupActivityScrollable(width, height int) string {
+	group, ok := m.inspectorData.(client.Group)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid group data")
+	}
+
+	var lines []string
+
+	activity, loaded := m.groupActivity[groupActivityKey(group)]
+	switch {
+	case !loaded || activity.loading:
+		lines = append(lines, m.spinner.View()+" Loading activity...")
+	case activity.err != nil:
+		lines = append(lines, styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", activity.err)))
+	case m.activeInspectorTab() == TabMembers:
+		lines = append(lines, renderGroupMembers(activity.members, width-8)...)
+	default:
+		lines = append(lines, renderGroupEvents(activity.events, width-8)...)
+	}
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// renderGroupMembers renders a group's members, most recently seen first
+func renderGroupMembers(members []client.GroupMember, width int) []string {
+	if len(members) == 0 {
+		return []string{styles.DimTextStyle.Render("No members seen in the last 90 days")}
+	}
+
+	lines := []string{styles.DimTextStyle.Render(fmt.Sprintf("%d members seen in the last 90 days", len(members))), ""}
+	for _, member := range members {
+		name := member.Email
+		if name == "" {
+			name = member.DistinctID
+		}
+		lines = append(lines, fmt.Sprintf("  %s %s",
+			styles.TruncateString(name, styles.Max(10, width-30)),
+			styles.DimTextStyle.Render(fmt.Sprintf("%d events · %s ago", member.Events, utils.FormatDuration(time.Since(member.LastSeen))))))
+	}
+	return lines
+}
+
+// renderGroupEvents renders a group's most recent events
+func renderGroupEvents(events []client.Event, width int) []string {
+	if len(events) == 0 {
+		return []string{styles.DimTextStyle.Render("No events for this group")}
+	}
+
+	var lines []string
+	for _, event := range events {
+		lines = append(lines, fmt.Sprintf("  %s %s %s",
+			styles.DimTextStyle.Render(client.FormatEventTimeShort(event.Timestamp.Local())),
+			styles.HighlightTextStyle.Render(styles.TruncateString(event.Event, styles.Max(10, width-40))),
+			styles.DimTextStyle.Render(styles.TruncateString(event.DistinctID, 24))))
+	}
+	return lines
+}
@@ -29,6 +29,8 @@ func (m Model) renderHelpOverlay(width, height int) string {
 				{"Ctrl+C", "Force quit"},
 				{"Tab / → / l", "Move focus right"},
 				{"Shift+Tab / ← / h / Esc", "Move focus left / Go back"},
+				{"b / Alt+←", "Back to the previous location (resource, list, item)"},
+				{"B / Alt+→", "Forward to the location gone back from"},
 			},
 		},
 		{
@@ -36,9 +38,7 @@ func (m Model) renderHelpOverlay(width, height int) string {
 			items: [][]string{
 				{"↑/↓ or j/k", "Navigate and select resources"},
 				{"Enter", "Cycle to next project"},
-				{"1", "Quick select Events"},
-				{"2", "Quick select Persons"},
-				{"3", "Quick select Flags"},
+				{"1-9", "Quick select resource by position"},
 			},
 		},
 		{
@@ -47,8 +47,30 @@ func (m Model) renderHelpOverlay(width, height int) string {
 				{"↑/↓ or j/k", "Navigate list (auto-updates Inspector)"},
 				{"G", "Jump to bottom (resume auto-scroll)"},
 				{"/", "Search/filter (modal)"},
+				{"/ (Persons)", "Search persons server-side: text, prop=value, prop~part"},
 				{"r", "Refresh current resource"},
 				{"p", "Pivot to person (Events only)"},
+				{"g", "Pivot to the event's group (Events), next group type (Groups)"},
+				{"t", "Trend chart of the event (Events only)"},
+				{"f", "Add the event as a funnel step (Events only)"},
+				{"v", "Top values of an event property (Events only)"},
+				{"S", "Group events by session (Events, Persons)"},
+				{"F", "Open funnel mode"},
+				{"Space", "Mark flag for bulk operation (Flags only)"},
+				{"A", "Mark/unmark all visible flags (Flags only)"},
+				{"D / E", "Preview disabling/enabling marked flags"},
+				{"Space / A", "Mark person / all visible (Persons only)"},
+				{"C", "Create static cohort from marked persons"},
+				{"L", "Tail live events of the definition, action or exception"},
+				{"x", "Clear the live event filter (Events only)"},
+				{"e / T / o", "Edit description / tags / owner (Schema only)"},
+				{"V / H", "Toggle verified / hidden (Schema only)"},
+				{"Space / A", "Mark definition / all visible, H hides marked"},
+				{"s", "Cycle sort column (Flag Audit), pick sort column (Persons)"},
+				{"c", "Show/hide person property columns (Persons only)"},
+				{"d", "Cycle 7/30/90 day window (Flag Audit, Actions, Exceptions)"},
+				{"Ctrl+S", "Export Flag Audit report or survey responses to CSV"},
+				{"a", "Create an annotation now (Annotations only)"},
 			},
 		},
 		{
@@ -57,9 +79,75 @@ func (m Model) renderHelpOverlay(width, height int) string {
 				{"j/k or ↑/↓", "Scroll content"},
 				{"Space", "Fold/expand JSON object at cursor"},
 				{"Shift+Z", "Fold/expand all top-level keys"},
+				{"[ / ]", "Previous/next tab (e.g. flag History, References)"},
+				{"n / N", "Next/previous page of members (Cohorts only)"},
+				{"L", "Tail live events of the definition, action or exception"},
+				{"e / T / V / H", "Edit event, or a property in the Properties tab"},
+				{"Ctrl+S", "Export survey responses to CSV (Surveys only)"},
+				{"j/k (Recordings tab)", "Select a session recording (Persons only)"},
+				{"o / u", "Open replay in browser / copy replay URL"},
+				{"j/k (History tab)", "Select a property to see its values over time (Persons only)"},
+				{"j/k / Enter (after pivot)", "Select / open an event of the person's timeline"},
+				{"n / J/K", "Load more timeline events / scroll person details"},
+				{"Esc or Backspace", "Return from an opened event to the timeline"},
 				{"y", "Copy full JSON to clipboard"},
 				{"c", "Copy ID to clipboard"},
 				{"p", "Pivot to person (Events only)"},
+				{"g", "Pivot to the event's group (Events only)"},
+				{"t", "Trend chart of the event (Events only)"},
+				{"f / F", "Add event as funnel step / open funnel mode"},
+				{"v", "Top values of an event property (Events only)"},
+				{"S", "Group events by session (Events, Persons)"},
+			},
+		},
+		{
+			title: "Sessions Mode",
+			items: [][]string{
+				{"j/k", "Select a session"},
+				{"J/K or Ctrl+D/U", "Scroll the session timeline"},
+				{"c", "Copy the session ID"},
+				{"r", "Refresh"},
+				{"Esc / q", "Close"},
+			},
+		},
+		{
+			title: "Trend Chart",
+			items: [][]string{
+				{"w", "Cycle 24h/7d/30d window"},
+				{"i", "Toggle hourly/daily buckets"},
+				{"b", "Break down by a property of the event"},
+				{"x", "Clear breakdown"},
+				{"r", "Refresh"},
+				{"Esc / q", "Close"},
+			},
+		},
+		{
+			title: "Property Breakdown",
+			items: [][]string{
+				{"w", "Cycle 24h/7d/30d window"},
+				{"k", "Pick another property"},
+				{"r", "Refresh"},
+				{"Esc / q", "Close"},
+			},
+		},
+		{
+			title: "Funnel Mode",
+			items: [][]string{
+				{"a", "Add a step from event definitions (fuzzy picker)"},
+				{"x / Backspace", "Remove the last step"},
+				{"X", "Clear all steps"},
+				{"w", "Cycle 7d/30d/90d/24h date range"},
+				{"c", "Cycle conversion window"},
+				{"Enter / r", "Run the funnel (2-5 steps)"},
+				{"Esc / q", "Close (steps are kept)"},
+			},
+		},
+		{
+			title: "Bulk Flag Preview",
+			items: [][]string{
+				{"y / Enter", "Apply changes (4 concurrent requests)"},
+				{"n / Esc", "Cancel"},
+				{"u", "Roll back the whole batch after applying"},
 			},
 		},
 		{
@@ -86,7 +174,7 @@ func (m Model) renderHelpOverlay(width, height int) string {
 
 			// Format: "  key........description"
 			keyStyled := styles.HighlightTextStyle.Render(key)
-			padding := strings.Repeat(" ", 20-len(key))
+			padding := strings.Repeat(" ", styles.Max(1, 20-lipgloss.Width(key)))
 			line := "  " + keyStyled + padding + desc
 			sb.WriteString(line)
 			sb.WriteString("\n")
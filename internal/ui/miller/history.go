@@ -0,0 +1,195 @@
+package miller
+
+import (
+	"strings"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// maxHistory is the number of locations kept to go back to
+	maxHistory = 50
+	// maxBreadcrumbs is the number of past locations shown in the trail
+	maxBreadcrumbs = 4
+	// maxBreadcrumbLen is the truncation length of a location in the trail
+	maxBreadcrumbLen = 30
+)
+
+// location is a snapshot of what was shown, restored when navigating back
+// or forward through the history
+type location struct {
+	resource       Resource
+	listItems      []ListItem
+	filteredItems  []ListItem
+	searchQuery    string
+	personSearch   string
+	listCursor     int
+	inspectorData  interface{}
+	inspectorTab   InspectorTab
+	focus          Focus
+	eventFilter    string
+	eventCondition string
+	autoScroll     bool
+	groupTypePos   int
+	timeline       *personTimeline
+}
+
+// currentLocation captures the current resource, list, filters, cursor and
+// inspected item
+func (m Model) currentLocation() location {
+	return location{
+		resource:       m.selectedResource,
+		listItems:      m.listItems,
+		filteredItems:  m.filteredItems,
+		searchQuery:    m.searchInput.Value(),
+		personSearch:   m.personSearch,
+		listCursor:     m.listCursor,
+		inspectorData:  m.inspectorData,
+		inspectorTab:   m.inspectorTab,
+		focus:          m.focus,
+		eventFilter:    m.eventFilter,
+		eventCondition: m.eventCondition,
+		autoScroll:     m.autoScroll,
+		groupTypePos:   m.groupTypePos,
+		timeline:       m.timeline,
+	}
+}
+
+// pushHistory records the current location before navigating away from it,
+// dropping the locations gone back from
+func (m *Model) pushHistory() {
+	m.backHistory = append(m.backHistory, m.currentLocation())
+	if len(m.backHistory) > maxHistory {
+		m.backHistory = m.backHistory[len(m.backHistory)-maxHistory:]
+	}
+	m.forwardHistory = nil
+}
+
+// navigateBack returns to the previous location, if any
+func (m *Model) navigateBack() tea.Cmd {
+	if len(m.backHistory) == 0 {
+		m.showClipboardFeedback("No earlier location")
+		return nil
+	}
+	m.forwardHistory = append(m.forwardHistory, m.currentLocation())
+	previous := m.backHistory[len(m.backHistory)-1]
+	m.backHistory = m.backHistory[:len(m.backHistory)-1]
+	return m.restoreLocation(previous)
+}
+
+// navigateForward returns to the location last gone back from, if any
+func (m *Model) navigateForward() tea.Cmd {
+	if len(m.forwardHistory) == 0 {
+		m.showClipboardFeedback("No later location")
+		return nil
+	}
+	m.backHistory = append(m.backHistory, m.currentLocation())
+	next := m.forwardHistory[len(m.forwardHistory)-1]
+	m.forwardHistory = m.forwardHistory[:len(m.forwardHistory)-1]
+	return m.restoreLocation(next)
+}
+
+// restoreLocation shows a location again and returns the command loading
+// the inspector tab's data, if not already loaded
+func (m *Model) restoreLocation(l location) tea.Cmd {
+	m.selectedResource = l.resource
+	m.pane1Cursor = int(l.resource)
+	m.pendingResourceFetch = nil
+	m.listItems = l.listItems
+	m.filteredItems = l.filteredItems
+	m.searchInput.SetValue(l.searchQuery)
+	m.personSearch = l.personSearch
+	m.listCursor = l.listCursor
+	m.inspectorData = l.inspectorData
+	m.inspectorTab = l.inspectorTab
+	m.focus = l.focus
+	m.eventFilter = l.eventFilter
+	m.eventCondition = l.eventCondition
+	m.autoScroll = l.autoScroll
+	m.newEventCount = 0
+	m.groupTypePos = l.groupTypePos
+	m.timeline = l.timeline
+	m.loading = false
+	m.err = nil
+	if l.resource == ResourcePersons {
+		// Lay out the restored persons with the current columns
+		m.refreshPersonLayout()
+	}
+	m.inspectorViewport.GotoTop()
+	return m.loadInspectorTab()
+}
+
+// clearHistory forgets all locations, e.g. after switching projects
+func (m *Model) clearHistory() {
+	m.backHistory = nil
+	m.forwardHistory = nil
+}
+
+// label describes a location in the breadcrumb trail
+func (l location) label() string {
+	label := l.resource.String()
+	if l.resource == ResourceEvents {
+		// The inspected event changes as the stream moves, the filter doesn't
+		if l.eventFilter != "" {
+			label += ": " + l.eventFilter
+		}
+		return styles.TruncateString(label, maxBreadcrumbLen)
+	}
+	if l.resource == ResourcePersons && l.personSearch != "" {
+		label += ": " + l.personSearch
+	}
+	switch data := l.inspectorData.(type) {
+	case client.Person:
+		name := data.Name
+		if name == "" && len(data.DistinctIDs) > 0 {
+			name = data.DistinctIDs[0]
+		}
+		if name != "" {
+			label += ": " + name
+		}
+	case client.Group:
+		label += ": " + data.Name()
+	case client.Event:
+		label += ": " + data.Event
+	}
+	return styles.TruncateString(label, maxBreadcrumbLen)
+}
+
+// renderHistoryTrail renders the locations navigated through up to the
+// current one, or "" before the first navigation
+func (m Model) renderHistoryTrail(width int) string {
+	if len(m.backHistory) == 0 && len(m.forwardHistory) == 0 {
+		return ""
+	}
+
+	current := m.currentLocation().label()
+	hint := " · b back"
+	if len(m.forwardHistory) > 0 {
+		hint += ", B forward"
+	}
+
+	// Show the most recent past locations that fit, eliding older ones
+	past := m.backHistory[styles.Max(0, len(m.backHistory)-maxBreadcrumbs):]
+	elided := len(past) < len(m.backHistory)
+	var trail string
+	for {
+		parts := make([]string, 0, len(past)+2)
+		if elided {
+			parts = append(parts, "…")
+		}
+		for _, l := range past {
+			parts = append(parts, l.label())
+		}
+		trail = strings.Join(append(parts, ""), " › ")
+		if len(past) == 0 || lipgloss.Width(trail+current+hint) <= width {
+			break
+		}
+		past = past[1:]
+		elided = true
+	}
+
+	return styles.DimTextStyle.Render(trail) + styles.HighlightTextStyle.Render(current) + styles.DimTextStyle.Render(hint)
+}
@@ -28,6 +28,12 @@ func (m Model) renderInspector(width, height int) string {
 	sb.WriteString(titleStyled)
 	sb.WriteString("\n\n")
 
+	// Tab bar for resources with several inspector views
+	if tabBar := m.renderInspectorTabBar(); tabBar != "" && m.inspectorData != nil {
+		sb.WriteString(tabBar)
+		sb.WriteString("\n\n")
+	}
+
 	// Empty state
 	if m.inspectorData == nil {
 		emptyMsg := "Select an item to view details"
@@ -39,9 +45,60 @@ func (m Model) renderInspector(width, height int) string {
 		case ResourceEvents:
 			sb.WriteString(m.renderEventInspectorScrollable(width, height))
 		case ResourcePersons:
-			sb.WriteString(m.renderPersonInspectorScrollable(width, height))
+			if m.activeInspectorTab() == TabRecordings {
+				sb.WriteString(m.renderPersonRecordingsScrollable(width, height))
+			} else if m.activeInspectorTab() == TabHistory {
+				sb.WriteString(m.renderPersonHistoryScrollable(width, height))
+			} else if m.timelineShown() {
+				sb.WriteString(m.renderPersonTimelineScrollable(width, height))
+			} else {
+				sb.WriteString(m.renderPersonInspectorScrollable(width, height))
+			}
 		case ResourceFlags:
-			sb.WriteString(m.renderFlagInspectorScrollable(width, height))
+			switch m.activeInspectorTab() {
+			case TabHistory:
+				sb.WriteString(m.renderFlagHistoryScrollable(width, height))
+			case TabReferences:
+				sb.WriteString(m.renderFlagRefsScrollable(width, height))
+			default:
+				sb.WriteString(m.renderFlagInspectorScrollable(width, height))
+			}
+		case ResourceFlagAudit:
+			sb.WriteString(m.renderFlagAuditInspectorScrollable(width, height))
+		case ResourceExperiments:
+			sb.WriteString(m.renderExperimentInspectorScrollable(width, height))
+		case ResourceDashboards:
+			sb.WriteString(m.renderDashboardInspectorScrollable(width, height))
+		case ResourceSchema:
+			if m.activeInspectorTab() == TabProperties {
+				sb.WriteString(m.renderEventPropertiesScrollable(width, height))
+			} else {
+				sb.WriteString(m.renderSchemaInspectorScrollable(width, height))
+			}
+		case ResourceActions:
+			sb.WriteString(m.renderActionInspectorScrollable(width, height))
+		case ResourceAnnotations:
+			sb.WriteString(m.renderAnnotationInspectorScrollable(width, height))
+		case ResourceExceptions:
+			sb.WriteString(m.renderExceptionInspectorScrollable(width, height))
+		case ResourceGroups:
+			if m.activeInspectorTab() == TabDetails {
+				sb.WriteString(m.renderGroupInspectorScrollable(width, height))
+			} else {
+				sb.WriteString(m.renderGroupActivityScrollable(width, height))
+			}
+		case ResourceSurveys:
+			if m.activeInspectorTab() == TabResponses {
+				sb.WriteString(m.renderSurveyResponsesScrollable(width, height))
+			} else {
+				sb.WriteString(m.renderSurveyInspectorScrollable(width, height))
+			}
+		case ResourceCohorts:
+			if m.activeInspectorTab() == TabMembers {
+				sb.WriteString(m.renderCohortMembersScrollable(width, height))
+			} else {
+				sb.WriteString(m.renderCohortInspectorScrollable(width, height))
+			}
 		}
 	}
 
@@ -84,9 +141,22 @@ func (m Model) renderEventInspectorScrollable(width, height int) string {
 	jsonLines := m.renderFoldedJSON(event.Properties, 0)
 	lines = append(lines, jsonLines...)
 
+	// Groups the event belongs to, each a pivot target
+	if groups := eventGroups(event); len(groups) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, styles.JSONKeyStyle.Render("Groups:"))
+		for _, group := range groups {
+			lines = append(lines, "  → "+styles.HighlightTextStyle.Underline(true).Render(group))
+		}
+	}
+
 	// Add hint for pivot
 	lines = append(lines, "")
-	lines = append(lines, styles.DimTextStyle.Render("Press 'p' to view this person"))
+	if len(eventGroups(event)) > 0 {
+		lines = append(lines, styles.DimTextStyle.Render("Press 'p' to view this person, 'g' to view a group"))
+	} else {
+		lines = append(lines, styles.DimTextStyle.Render("Press 'p' to view this person"))
+	}
 
 	// Build full content and update viewport
 	content := strings.Join(lines, "\n")
@@ -104,6 +174,19 @@ func (m Model) renderPersonInspectorScrollable(width, height int) string {
 		return styles.ErrorTextStyle.Render("Error: Invalid person data")
 	}
 
+	lines := m.personDetailLines(person)
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// personDetailLines renders a person's name, distinct IDs, cohorts and properties
+func (m Model) personDetailLines(person client.Person) []string {
 	var lines []string
 
 	// Person header
@@ -126,6 +209,11 @@ func (m Model) renderPersonInspectorScrollable(width, height int) string {
 		lines = append(lines, "")
 	}
 
+	// Cohorts
+	lines = append(lines, styles.JSONKeyStyle.Render("Cohorts:"))
+	lines = append(lines, m.renderPersonCohorts(person)...)
+	lines = append(lines, "")
+
 	// Properties
 	lines = append(lines, styles.JSONKeyStyle.Render("Properties:"))
 
@@ -136,13 +224,7 @@ func (m Model) renderPersonInspectorScrollable(width, height int) string {
 		lines = append(lines, jsonLines...)
 	}
 
-	// Build full content and update viewport
-	content := strings.Join(lines, "\n")
-	m.inspectorViewport.Width = width - 4
-	m.inspectorViewport.Height = height - 8
-	m.inspectorViewport.SetContent(content)
-
-	return m.inspectorViewport.View()
+	return lines
 }
 
 // renderFlagInspectorScrollable renders feature flag details with scrolling support
@@ -173,6 +255,13 @@ func (m Model) renderFlagInspectorScrollable(width, height int) string {
 	lines = append(lines, styles.JSONKeyStyle.Render("Status: ")+statusValue)
 	lines = append(lines, "")
 
+	// Release conditions, readable with cohort names resolved
+	if conditions := m.renderFlagReleaseConditions(flag); len(conditions) > 0 {
+		lines = append(lines, styles.JSONKeyStyle.Render("Release conditions:"))
+		lines = append(lines, conditions...)
+		lines = append(lines, "")
+	}
+
 	// Filters (if available)
 	if len(flag.Filters) > 0 {
 		lines = append(lines, styles.JSONKeyStyle.Render("Filters:"))
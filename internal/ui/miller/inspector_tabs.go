@@ -0,0 +1,255 @@
+package miller
+
+import (
+	"strings"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// InspectorTab identifies a sub-view of the inspector (Pane 3)
+type InspectorTab int
+
+const (
+	TabDetails InspectorTab = iota
+	TabHistory
+	TabReferences
+	TabMembers
+	TabProperties
+	TabResponses
+	TabRecordings
+	TabEvents
+)
+
+// String returns a human-readable representation of the tab
+func (t InspectorTab) String() string {
+	switch t {
+	case TabDetails:
+		return "Details"
+	case TabHistory:
+		return "History"
+	case TabReferences:
+		return "References"
+	case TabMembers:
+		return "Members"
+	case TabProperties:
+		return "Properties"
+	case TabResponses:
+		return "Responses"
+	case TabRecordings:
+		return "Recordings"
+	case TabEvents:
+		return "Events"
+	default:
+		return "Unknown"
+	}
+}
+
+// inspectorTabs returns the tabs available for a resource, in display order
+func inspectorTabs(r Resource) []InspectorTab {
+	switch r {
+	case ResourceFlags:
+		return []InspectorTab{TabDetails, TabHistory, TabReferences}
+	case ResourcePersons:
+		return []InspectorTab{TabDetails, TabHistory, TabRecordings}
+	case ResourceCohorts:
+		return []InspectorTab{TabDetails, TabMembers}
+	case ResourceSchema:
+		return []InspectorTab{TabDetails, TabProperties}
+	case ResourceSurveys:
+		return []InspectorTab{TabDetails, TabResponses}
+	case ResourceGroups:
+		return []InspectorTab{TabDetails, TabMembers, TabEvents}
+	default:
+		return []InspectorTab{TabDetails}
+	}
+}
+
+// activeInspectorTab returns the selected tab, falling back to Details
+// if the selected tab is not available for the current resource
+func (m Model) activeInspectorTab() InspectorTab {
+	for _, tab := range inspectorTabs(m.selectedResource) {
+		if tab == m.inspectorTab {
+			return tab
+		}
+	}
+	return TabDetails
+}
+
+// cycleInspectorTab moves to the next (delta > 0) or previous (delta < 0) tab
+func (m *Model) cycleInspectorTab(delta int) {
+	tabs := inspectorTabs(m.selectedResource)
+	if len(tabs) < 2 {
+		return
+	}
+
+	current := 0
+	active := m.activeInspectorTab()
+	for i, tab := range tabs {
+		if tab == active {
+			current = i
+			break
+		}
+	}
+
+	next := (current + delta + len(tabs)) % len(tabs)
+	m.inspectorTab = tabs[next]
+	m.inspectorViewport.GotoTop()
+}
+
+// loadInspectorTab returns a command fetching the data the active tab needs
+// for the current inspector item (e.g. flag history, experiment results),
+// or nil if it is already loaded
+func (m *Model) loadInspectorTab() tea.Cmd {
+	switch m.activeInspectorTab() {
+	case TabDetails:
+		switch data := m.inspectorData.(type) {
+		case client.Experiment:
+			if data.Status() == client.ExperimentDraft {
+				return nil
+			}
+			if _, loaded := m.experimentResults[data.ID]; loaded {
+				return nil
+			}
+			m.experimentResults[data.ID] = experimentResult{loading: true}
+			return fetchExperimentResults(m.client, data.ID)
+
+		case client.Person:
+			uuid := personUUID(data)
+			if _, loaded := m.personCohorts[uuid]; loaded || uuid == "" {
+				return nil
+			}
+			m.personCohorts[uuid] = personCohorts{loading: true}
+			return fetchPersonCohorts(m.client, uuid)
+
+		case client.FeatureFlag:
+			// Resolve cohort IDs in release conditions to names
+			if m.cohortNames != nil || m.cohortNamesLoading || len(utils.CohortIDsInFilters(data.Filters)) == 0 {
+				return nil
+			}
+			m.cohortNamesLoading = true
+			return fetchCohortNames(m.client)
+
+		case client.Action:
+			return m.loadActionCount(data)
+
+		case client.ExceptionGroup:
+			return m.loadExceptionDetail(data)
+
+		case client.Dashboard:
+			if _, loaded := m.dashboards[data.ID]; loaded {
+				return m.loadChartAnnotations()
+			}
+			m.dashboards[data.ID] = dashboardDetail{loading: true}
+			return tea.Batch(fetchDashboardDetail(m.client, data.ID), m.loadChartAnnotations())
+		}
+
+	case TabHistory:
+		if person, ok := m.inspectorData.(client.Person); ok {
+			uuid := personUUID(person)
+			if _, loaded := m.propertyHistories[uuid]; loaded || uuid == "" {
+				return nil
+			}
+			m.propertyHistories[uuid] = personPropertyHistory{loading: true}
+			return fetchPersonPropertyHistory(m.client, uuid)
+		}
+		flag, ok := m.inspectorData.(client.FeatureFlag)
+		if !ok {
+			return nil
+		}
+		if _, loaded := m.flagHistories[flag.ID]; loaded {
+			return nil
+		}
+		m.flagHistories[flag.ID] = flagHistory{loading: true}
+		return fetchFlagActivity(m.client, flag.ID)
+
+	case TabReferences:
+		if m.repoPath == "" || m.flagRefs != nil || m.flagRefsLoading || m.flagRefsErr != nil {
+			return nil
+		}
+		keys := m.flagKeys()
+		if len(keys) == 0 {
+			return nil
+		}
+		m.flagRefsLoading = true
+		return scanFlagRefs(m.repoPath, keys)
+
+	case TabMembers:
+		if group, ok := m.inspectorData.(client.Group); ok {
+			return m.loadGroupActivity(group)
+		}
+		cohort, ok := m.inspectorData.(client.Cohort)
+		if !ok {
+			return nil
+		}
+		if _, loaded := m.cohortMembers[cohort.ID]; loaded {
+			return nil
+		}
+		m.cohortMembers[cohort.ID] = cohortMembers{loading: true}
+		return fetchCohortMembers(m.client, cohort.ID, 0)
+
+	case TabProperties:
+		definition, ok := m.inspectorData.(client.EventDefinition)
+		if !ok {
+			return nil
+		}
+		if _, loaded := m.eventProperties[definition.Name]; loaded {
+			return nil
+		}
+		m.eventProperties[definition.Name] = eventProperties{loading: true}
+		return fetchEventProperties(m.client, definition.Name)
+
+	case TabResponses:
+		survey, ok := m.inspectorData.(client.Survey)
+		if !ok {
+			return nil
+		}
+		if _, loaded := m.surveyResponses[survey.ID]; loaded {
+			return nil
+		}
+		m.surveyResponses[survey.ID] = surveyResponses{loading: true}
+		return fetchSurveyResponses(m.client, survey, false)
+
+	case TabEvents:
+		group, ok := m.inspectorData.(client.Group)
+		if !ok {
+			return nil
+		}
+		return m.loadGroupActivity(group)
+
+	case TabRecordings:
+		person, ok := m.inspectorData.(client.Person)
+		if !ok {
+			return nil
+		}
+		uuid := personUUID(person)
+		if _, loaded := m.personRecordings[uuid]; loaded || uuid == "" {
+			return nil
+		}
+		m.personRecordings[uuid] = personRecordings{loading: true}
+		return fetchPersonRecordings(m.client, uuid)
+	}
+	return nil
+}
+
+// renderInspectorTabBar renders the tab headers for resources with several tabs
+func (m Model) renderInspectorTabBar() string {
+	tabs := inspectorTabs(m.selectedResource)
+	if len(tabs) < 2 {
+		return ""
+	}
+
+	active := m.activeInspectorTab()
+	parts := make([]string, len(tabs))
+	for i, tab := range tabs {
+		if tab == active {
+			parts[i] = styles.HighlightTextStyle.Render("[" + tab.String() + "]")
+		} else {
+			parts[i] = styles.DimTextStyle.Render(" " + tab.String() + " ")
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -81,9 +81,15 @@ func (e EventListItem) GetSearchableText() string {
 // PersonListItem wraps a client.Person for list display
 type PersonListItem struct {
 	Person client.Person
+	Marked bool          // selected for a new static cohort
+	Layout *personLayout // property columns, nil for just the name and distinct ID
 }
 
 func (p PersonListItem) RenderLine(width int, selected bool) string {
+	if p.Layout != nil {
+		return p.renderColumns(width, selected)
+	}
+
 	name := p.Person.Name
 	if name == "" {
 		name = "(no name)"
@@ -104,6 +110,11 @@ func (p PersonListItem) RenderLine(width int, selected bool) string {
 
 	line := fmt.Sprintf("%s %s", name, styles.DimTextStyle.Render(distinctID))
 
+	// Cohort selection indicator
+	if p.Marked {
+		line = styles.WarningTextStyle.Render("✓") + " " + line
+	}
+
 	if selected {
 		line = styles.SelectedListItemStyle.Render("▶ " + line)
 	} else {
@@ -113,6 +124,21 @@ func (p PersonListItem) RenderLine(width int, selected bool) string {
 	return line
 }
 
+// renderColumns renders the person's name and property columns, aligned
+// with the other rows
+func (p PersonListItem) renderColumns(width int, selected bool) string {
+	mark := "  "
+	if p.Marked {
+		mark = styles.WarningTextStyle.Render("✓") + " "
+	}
+	line := mark + p.Layout.row(p.Person, width-8)
+
+	if selected {
+		return styles.SelectedListItemStyle.Render("▶ " + line)
+	}
+	return styles.ListItemStyle.Render("  " + line)
+}
+
 func (p PersonListItem) GetID() string {
 	if len(p.Person.DistinctIDs) > 0 {
 		return p.Person.DistinctIDs[0]
@@ -141,7 +167,8 @@ func (p PersonListItem) GetSearchableText() string {
 
 // FlagListItem wraps a client.FeatureFlag for list display
 type FlagListItem struct {
-	Flag client.FeatureFlag
+	Flag   client.FeatureFlag
+	Marked bool // selected for a bulk operation
 }
 
 func (f FlagListItem) RenderLine(width int, selected bool) string {
@@ -153,6 +180,13 @@ func (f FlagListItem) RenderLine(width int, selected bool) string {
 		status = "●"
 	}
 
+	// Bulk selection indicator
+	if f.Marked {
+		status = styles.WarningTextStyle.Render("✓") + status
+	} else {
+		status = " " + status
+	}
+
 	// Truncate if needed
 	if len(key) > maxFlagKeyLen {
 		key = styles.TruncateString(key, maxFlagKeyLen)
@@ -194,11 +228,27 @@ func (m Model) renderListView(width, height int) string {
 	if m.selectedResource == ResourceEvents {
 		indicator := m.getAutoScrollIndicator()
 		title += " " + indicator
+		if m.eventFilter != "" {
+			title += " " + styles.HighlightTextStyle.Render(m.eventFilter)
+		}
+	} else if m.selectedResource == ResourceFlagAudit {
+		title = m.flagAuditTitle()
+	} else if m.selectedResource == ResourceGroups {
+		title = m.groupsTitle()
+	} else if m.selectedResource == ResourcePersons && m.personSearch != "" {
+		title += " " + styles.HighlightTextStyle.Render(m.personSearch)
 	}
 	titleStyled := styles.TitleStyle.Render(title)
 	sb.WriteString(titleStyled)
 	sb.WriteString("\n\n")
 
+	// Column headers of the Persons list
+	showPersonHeader := m.selectedResource == ResourcePersons && m.personLayout != nil && m.err == nil && len(m.getEffectiveListItems()) > 0
+	if showPersonHeader {
+		sb.WriteString(m.personLayout.renderHeader(width - 4))
+		sb.WriteString("\n")
+	}
+
 	// Search input overlay if active
 	if m.searchMode {
 		searchInput := m.renderSearchInput(width)
@@ -206,6 +256,12 @@ func (m Model) renderListView(width, height int) string {
 		sb.WriteString("\n")
 	}
 
+	// Text prompt overlay if active
+	if m.prompt != nil {
+		sb.WriteString(m.renderPrompt(width))
+		sb.WriteString("\n")
+	}
+
 	// Error state
 	if m.err != nil {
 		sb.WriteString(styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", m.err)))
@@ -228,8 +284,11 @@ func (m Model) renderListView(width, height int) string {
 		} else {
 			// Render list items with viewport management
 			visibleHeight := height - 8
-			if m.searchMode {
-				visibleHeight -= 2 // Account for search input overlay
+			if m.searchMode || m.prompt != nil {
+				visibleHeight -= 2 // Account for search input or prompt overlay
+			}
+			if showPersonHeader {
+				visibleHeight--
 			}
 			if visibleHeight < 5 {
 				visibleHeight = 5
@@ -289,6 +348,10 @@ func (m Model) getEmptyStateMessage() string {
 		icon = "👤"
 		message = "No persons found"
 		hint = "Persons are created when events are sent with distinct IDs"
+		if m.personSearch != "" {
+			message = "No persons match " + m.personSearch
+			hint = "Press / to change the search, or Enter on an empty search to list all"
+		}
 		if m.loading {
 			return lipgloss.JoinVertical(lipgloss.Left,
 				styles.H1Style.Render(icon),
@@ -305,6 +368,110 @@ func (m Model) getEmptyStateMessage() string {
 				m.spinner.View()+" Loading flags...",
 			)
 		}
+	case ResourceFlagAudit:
+		icon = "🧹"
+		message = "No feature flags to audit"
+		hint = "Flags are listed with their usage from $feature_flag_called events"
+		if m.loading {
+			return lipgloss.JoinVertical(lipgloss.Left,
+				styles.H1Style.Render(icon),
+				m.spinner.View()+" Auditing flags...",
+			)
+		}
+	case ResourceExperiments:
+		icon = "🧪"
+		message = "No experiments"
+		hint = "Create experiments in PostHog to A/B test feature flag variants"
+		if m.loading {
+			return lipgloss.JoinVertical(lipgloss.Left,
+				styles.H1Style.Render(icon),
+				m.spinner.View()+" Loading experiments...",
+			)
+		}
+	case ResourceCohorts:
+		icon = "👥"
+		message = "No cohorts"
+		hint = "Create cohorts in PostHog to group persons by properties or behavior"
+		if m.loading {
+			return lipgloss.JoinVertical(lipgloss.Left,
+				styles.H1Style.Render(icon),
+				m.spinner.View()+" Loading cohorts...",
+			)
+		}
+	case ResourceDashboards:
+		icon = "📊"
+		message = "No dashboards"
+		hint = "Create dashboards in PostHog to group insights"
+		if m.loading {
+			return lipgloss.JoinVertical(lipgloss.Left,
+				styles.H1Style.Render(icon),
+				m.spinner.View()+" Loading dashboards...",
+			)
+		}
+	case ResourceSchema:
+		icon = "📖"
+		message = "No event definitions"
+		hint = "Event definitions appear once events are ingested"
+		if m.loading {
+			return lipgloss.JoinVertical(lipgloss.Left,
+				styles.H1Style.Render(icon),
+				m.spinner.View()+" Loading event definitions...",
+			)
+		}
+	case ResourceActions:
+		icon = "🎯"
+		message = "No actions"
+		hint = "Create actions in PostHog to name combinations of events"
+		if m.loading {
+			return lipgloss.JoinVertical(lipgloss.Left,
+				styles.H1Style.Render(icon),
+				m.spinner.View()+" Loading actions...",
+			)
+		}
+	case ResourceAnnotations:
+		icon = "📌"
+		message = "No annotations"
+		hint = "Press a to annotate now, or run lazyhog annotate \"Deployed v2.3\""
+		if m.loading {
+			return lipgloss.JoinVertical(lipgloss.Left,
+				styles.H1Style.Render(icon),
+				m.spinner.View()+" Loading annotations...",
+			)
+		}
+	case ResourceSurveys:
+		icon = "📋"
+		message = "No surveys"
+		hint = "Create surveys in PostHog to collect feedback from users"
+		if m.loading {
+			return lipgloss.JoinVertical(lipgloss.Left,
+				styles.H1Style.Render(icon),
+				m.spinner.View()+" Loading surveys...",
+			)
+		}
+	case ResourceExceptions:
+		icon = "💥"
+		message = "No exceptions"
+		hint = "Exceptions appear here once $exception events are captured"
+		if m.loading {
+			return lipgloss.JoinVertical(lipgloss.Left,
+				styles.H1Style.Render(icon),
+				m.spinner.View()+" Loading exceptions...",
+			)
+		}
+	case ResourceGroups:
+		icon = "🏢"
+		message = "No groups"
+		hint = "Groups appear once events are sent with group analytics"
+		if len(m.groupTypes) == 0 {
+			message = "No group types"
+			hint = "Set up group analytics in PostHog to analyze companies or workspaces"
+		}
+		if m.loading {
+			return lipgloss.JoinVertical(lipgloss.Left,
+				styles.H1Style.Render(icon),
+				m.spinner.View()+" Loading groups...",
+			)
+		}
 	default:
 		return "No data available."
 	}
@@ -342,6 +509,8 @@ func (m *Model) SelectCurrentListItem() {
 	}
 
 	m.inspectorData = effectiveItems[m.listCursor].GetInspectorData()
+	m.recordingCursor = 0
+	m.propertyCursor = 0
 	m.focus = FocusPane3
 	// Reset scroll when selecting new item
 	m.inspectorViewport.GotoTop()
@@ -355,6 +524,18 @@ func (m *Model) updateInspectorFromCursor() {
 	}
 
 	m.inspectorData = effectiveItems[m.listCursor].GetInspectorData()
+	m.recordingCursor = 0
+	m.propertyCursor = 0
 	// Reset scroll when updating item
 	m.inspectorViewport.GotoTop()
 }
+
+// clampListCursor keeps the list cursor within the bounds of the list
+func (m *Model) clampListCursor() {
+	if m.listCursor >= len(m.listItems) && len(m.listItems) > 0 {
+		m.listCursor = len(m.listItems) - 1
+	}
+	if m.listCursor < 0 {
+		m.listCursor = 0
+	}
+}
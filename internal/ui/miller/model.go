@@ -3,11 +3,14 @@ package miller
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 	"time"
 
 	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/config"
 	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
@@ -37,14 +40,15 @@ const (
 
 // Model represents the Miller Columns TUI state
 type Model struct {
-	client client.PostHogClient
-	width  int
-	height int
+	client   client.PostHogClient
+	width    int
+	height   int
+	repoPath string // local source tree scanned for flag references
 
 	// --- Focus and Navigation ---
 	focus            Focus
 	selectedResource Resource
-	pane1Cursor      int // -1 = project, 0 = Events, 1 = Persons, 2 = Flags
+	pane1Cursor      int // -1 = project, otherwise an index into allResources
 
 	// --- Project State ---
 	availableProjects []client.Project
@@ -61,6 +65,72 @@ type Model struct {
 	inspectorViewport viewport.Model
 	jsonFoldState     map[string]bool // JSON path -> folded status
 	allFolded         bool
+	inspectorTab      InspectorTab
+
+	// --- Flag History State ---
+	flagHistories map[int]flagHistory // flag ID -> activity log
+
+	// --- Flag References State ---
+	flagRefs        map[string][]utils.CodeReference // flag key -> references, nil until scanned
+	flagRefsLoading bool
+	flagRefsErr     error
+
+	// --- Bulk Flag State ---
+	markedFlags map[int]bool   // flag ID -> selected for a bulk operation
+	bulk        *bulkOperation // non-nil while the bulk overlay is shown
+
+	// --- Flag Audit State ---
+	flagAuditRows []utils.FlagAuditRow
+	flagAuditDays int
+	flagAuditSort utils.FlagAuditSort
+
+	// --- Experiment State ---
+	experimentResults map[int]experimentResult // experiment ID -> results
+
+	// --- Cohort State ---
+	cohortNames        map[int]string // cohort ID -> name, nil until fetched
+	cohortNamesLoading bool
+	cohortMembers      map[int]cohortMembers       // cohort ID -> loaded page of members
+	personCohorts      map[string]personCohorts    // person UUID -> cohorts
+	personRecordings   map[string]personRecordings // person UUID -> session recordings
+	recordingCursor    int                         // selected recording in the Recordings tab
+	markedPersons      map[string]bool             // person UUID -> selected for a new cohort
+
+	// --- Person Property History State ---
+	propertyHistories map[string]personPropertyHistory // person UUID -> history of property values
+	propertyCursor    int                              // selected property in the person History tab
+
+	// --- Dashboard State ---
+	dashboards map[int]dashboardDetail // dashboard ID -> tiles with results
+
+	// --- Schema State ---
+	eventProperties   map[string]eventProperties // event name -> property definitions
+	eventFilter       string                     // label of the live Events list filter, if set
+	eventCondition    string                     // HogQL condition the live Events list is filtered by
+	markedDefinitions map[string]bool            // event definition ID -> selected for a bulk hide
+	members           []client.DefinitionOwner   // organization members, nil until fetched
+	ownerPickerWait   bool                       // open the owner picker once members arrive
+
+	// --- Actions State ---
+	actionCounts map[string]actionCount // action ID and window -> matching event count
+	actionDays   int                    // match count window in days
+
+	// --- Annotations State ---
+	annotations        []client.Annotation // newest first, nil until fetched
+	annotationsLoading bool
+
+	// --- Surveys State ---
+	surveyResponseCounts map[string]int             // survey ID -> response count, nil if unknown
+	surveyResponses      map[string]surveyResponses // survey ID -> fetched responses
+
+	// --- Exceptions State ---
+	exceptionDays    int                        // window in days
+	exceptionDetails map[string]exceptionDetail // window and group key -> trend and latest occurrence
+
+	// --- Groups State ---
+	groupTypes    []client.GroupType       // nil until fetched
+	groupTypePos  int                      // position in groupTypes of the listed type
+	groupActivity map[string]groupActivity // group type index and key -> members and events
 
 	// --- Auto-scroll State ---
 	autoScroll      bool
@@ -71,6 +141,43 @@ type Model struct {
 	searchMode  bool
 	searchInput textinput.Model
 
+	// --- Prompt State ---
+	prompt *textPrompt // non-nil while a text prompt is shown
+	picker *picker     // non-nil while a picker is shown
+
+	// --- Trend State ---
+	trend *trendView // non-nil while the trend chart is shown
+
+	// --- Property Breakdown State ---
+	breakdown *breakdownView // non-nil while a property breakdown is shown
+
+	// --- Person Search State ---
+	personSearch string // server-side search the Persons list is narrowed by, if set
+
+	// --- Person Columns State ---
+	cfg                  *config.Config   // nil if not loaded, the person columns are then not saved
+	personColumnSets     map[int][]string // project ID -> person columns shown
+	personColumnsSaving  bool             // true while the column sets are written to the config file
+	personColumnsUnsaved bool             // true if the column sets changed during the save in progress
+	personLayout         *personLayout    // column layout of the listed persons, nil until listed
+	personSortBy         string           // column the Persons list is sorted by, "" for the server's order
+	personSortDesc       bool
+
+	// --- History State ---
+	backHistory    []location // locations navigated away from, oldest first
+	forwardHistory []location // locations gone back from, most recent last
+
+	// --- Person Timeline State ---
+	timeline *personTimeline // events of the person last pivoted to, nil until a pivot
+
+	// --- Sessions State ---
+	sessions *sessionsView // non-nil while Sessions mode is shown
+
+	// --- Funnel State ---
+	funnel     *funnelView // funnel being built, kept while the overlay is closed
+	funnelOpen bool
+	eventNames []string // event definition names for pickers, nil until fetched
+
 	// --- Polling State ---
 	isPolling       bool
 	lastInteraction time.Time
@@ -103,19 +210,30 @@ type flagsMsg []client.FeatureFlag
 type projectsMsg []client.Project
 type errorMsg struct{ err error }
 type pivotMsg struct {
+	distinctID  string
 	person      *client.Person
 	events      []client.Event
 	eventsError error // Non-fatal error when fetching events
 }
 
 // New creates a new Miller Columns model
-func New(c client.PostHogClient) Model {
+func New(c client.PostHogClient, cfg *config.Config) Model {
 	s := spinner.New()
 	s.Spinner = spinner.Dot
 	s.Style = styles.SpinnerStyle
 
+	repoPath := ""
+	personColumnSets := make(map[int][]string)
+	if cfg != nil {
+		repoPath = cfg.RepoPath
+		maps.Copy(personColumnSets, cfg.PersonColumns)
+	}
+
 	return Model{
 		client:               c,
+		repoPath:             repoPath,
+		cfg:                  cfg,
+		personColumnSets:     personColumnSets,
 		focus:                FocusPane1,
 		selectedResource:     ResourceEvents,
 		pane1Cursor:          0, // Start on Events
@@ -129,6 +247,26 @@ func New(c client.PostHogClient) Model {
 		inspectorViewport:    viewport.New(0, 0), // Will be sized on WindowSizeMsg
 		jsonFoldState:        make(map[string]bool),
 		allFolded:            false,
+		inspectorTab:         TabDetails,
+		flagHistories:        make(map[int]flagHistory),
+		markedFlags:          make(map[int]bool),
+		flagAuditDays:        flagAuditWindows[1],
+		flagAuditSort:        utils.FlagAuditSortCandidate,
+		experimentResults:    make(map[int]experimentResult),
+		cohortMembers:        make(map[int]cohortMembers),
+		personCohorts:        make(map[string]personCohorts),
+		personRecordings:     make(map[string]personRecordings),
+		propertyHistories:    make(map[string]personPropertyHistory),
+		markedPersons:        make(map[string]bool),
+		dashboards:           make(map[int]dashboardDetail),
+		eventProperties:      make(map[string]eventProperties),
+		markedDefinitions:    make(map[string]bool),
+		actionCounts:         make(map[string]actionCount),
+		actionDays:           actionCountWindows[0],
+		exceptionDays:        exceptionWindows[0],
+		exceptionDetails:     make(map[string]exceptionDetail),
+		groupActivity:        make(map[string]groupActivity),
+		surveyResponses:      make(map[string]surveyResponses),
 		autoScroll:           true,
 		newEventCount:        0,
 		lastSeenEventID:      "",
@@ -165,22 +303,50 @@ func tickCmd() tea.Cmd {
 func (m Model) fetchCurrentResource() tea.Cmd {
 	switch m.selectedResource {
 	case ResourceEvents:
-		return fetchEvents(m.client)
+		return fetchEvents(m.client, m.eventCondition)
 	case ResourcePersons:
-		return fetchPersons(m.client)
+		return fetchPersons(m.client, m.personSearch)
 	case ResourceFlags:
 		return fetchFlags(m.client)
+	case ResourceFlagAudit:
+		return fetchFlagAudit(m.client, m.flagAuditDays)
+	case ResourceExperiments:
+		return fetchExperiments(m.client)
+	case ResourceCohorts:
+		return fetchCohorts(m.client)
+	case ResourceDashboards:
+		return fetchDashboards(m.client)
+	case ResourceSchema:
+		return fetchSchema(m.client)
+	case ResourceActions:
+		return fetchActions(m.client)
+	case ResourceAnnotations:
+		return fetchAnnotations(m.client)
+	case ResourceSurveys:
+		return fetchSurveys(m.client)
+	case ResourceExceptions:
+		return fetchExceptions(m.client, m.exceptionDays)
+	case ResourceGroups:
+		return fetchGroups(m.client, m.groupTypePos)
 	default:
 		return nil
 	}
 }
 
-func fetchEvents(c client.PostHogClient) tea.Cmd {
+// fetchEvents fetches the most recent events, only those matching the HogQL
+// condition if set
+func fetchEvents(c client.PostHogClient, condition string) tea.Cmd {
 	return func() tea.Msg {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		events, err := c.ListRecentEvents(ctx, maxEvents)
+		var events []client.Event
+		var err error
+		if condition != "" {
+			events, err = c.ListRecentEventsMatching(ctx, condition, maxEvents)
+		} else {
+			events, err = c.ListRecentEvents(ctx, maxEvents)
+		}
 		if err != nil {
 			return errorMsg{err: err}
 		}
@@ -188,12 +354,12 @@ func fetchEvents(c client.PostHogClient) tea.Cmd {
 	}
 }
 
-func fetchPersons(c client.PostHogClient) tea.Cmd {
+func fetchPersons(c client.PostHogClient, search string) tea.Cmd {
 	return func() tea.Msg {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		persons, err := c.ListPersons(ctx, maxPersons)
+		persons, err := c.SearchPersons(ctx, client.ParsePersonSearch(search), maxPersons)
 		if err != nil {
 			return errorMsg{err: err}
 		}
@@ -243,7 +409,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.lastPoll = time.Now()
 			return m, tea.Batch(
 				tickCmd(),
-				fetchEvents(m.client),
+				fetchEvents(m.client, m.eventCondition),
 			)
 		}
 		return m, tickCmd()
@@ -286,6 +452,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.err = nil
 
+		m.refreshPersonMarks()
+		m.refreshPersonLayout()
+
+		// Cohort membership may have changed since it was fetched
+		m.personCohorts = make(map[string]personCohorts)
+		// Recordings may have been made since they were fetched
+		m.personRecordings = make(map[string]personRecordings)
+		// Properties may have changed since their history was fetched
+		m.propertyHistories = make(map[string]personPropertyHistory)
+
 		// Adjust cursor if out of bounds
 		if m.listCursor >= len(m.listItems) && len(m.listItems) > 0 {
 			m.listCursor = len(m.listItems) - 1
@@ -294,7 +470,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.listCursor = 0
 		}
 
-		return m, nil
+		return m, m.loadInspectorTab()
 
 	case flagsMsg:
 		m.listItems = make([]ListItem, len(msg))
@@ -304,6 +480,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.err = nil
 
+		m.refreshFlagMarks()
+
+		// Flags may have changed since their history and references were fetched
+		m.flagHistories = make(map[int]flagHistory)
+		m.flagRefs = nil
+		m.flagRefsErr = nil
+
 		// Adjust cursor if out of bounds
 		if m.listCursor >= len(m.listItems) && len(m.listItems) > 0 {
 			m.listCursor = len(m.listItems) - 1
@@ -312,6 +495,283 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.listCursor = 0
 		}
 
+		return m, m.loadInspectorTab()
+
+	case flagActivityMsg:
+		m.flagHistories[msg.flagID] = flagHistory{
+			entries: msg.entries,
+			err:     msg.err,
+		}
+		return m, nil
+
+	case bulkResultMsg:
+		m.applyBulkResults(msg)
+		return m, nil
+
+	case trendMsg:
+		m.applyTrend(msg)
+		return m, nil
+
+	case breakdownMsg:
+		m.applyBreakdown(msg)
+		return m, nil
+
+	case sessionEventsMsg:
+		m.applySessionEvents(msg)
+		return m, nil
+
+	case funnelMsg:
+		m.applyFunnel(msg)
+		return m, nil
+
+	case eventNamesMsg:
+		return m, m.applyEventNames(msg)
+
+	case flagRefsMsg:
+		m.flagRefs = msg.refs
+		m.flagRefsErr = msg.err
+		m.flagRefsLoading = false
+		return m, nil
+
+	case flagAuditMsg:
+		m.flagAuditRows = msg
+		utils.SortFlagAudit(m.flagAuditRows, m.flagAuditSort)
+		m.setFlagAuditListItems()
+		m.loading = false
+		m.err = nil
+		m.clampListCursor()
+		return m, nil
+
+	case experimentsMsg:
+		m.listItems = make([]ListItem, len(msg))
+		for i, exp := range msg {
+			m.listItems[i] = ExperimentListItem{Experiment: exp}
+		}
+		m.loading = false
+		m.err = nil
+		m.clampListCursor()
+
+		// Results may have changed since they were fetched
+		m.experimentResults = make(map[int]experimentResult)
+		return m, m.loadInspectorTab()
+
+	case experimentResultsMsg:
+		m.experimentResults[msg.experimentID] = experimentResult{
+			results: msg.results,
+			err:     msg.err,
+		}
+		return m, nil
+
+	case cohortsMsg:
+		m.listItems = make([]ListItem, len(msg))
+		for i, cohort := range msg {
+			m.listItems[i] = CohortListItem{Cohort: cohort}
+		}
+		m.loading = false
+		m.err = nil
+		m.clampListCursor()
+
+		m.setCohortNames(msg)
+		// Membership may have changed since it was fetched
+		m.cohortMembers = make(map[int]cohortMembers)
+		m.personCohorts = make(map[string]personCohorts)
+		return m, m.loadInspectorTab()
+
+	case cohortNamesMsg:
+		m.cohortNamesLoading = false
+		if msg.err == nil {
+			m.setCohortNames(msg.cohorts)
+		}
+		return m, nil
+
+	case cohortMembersMsg:
+		m.cohortMembers[msg.cohortID] = cohortMembers{
+			persons: msg.persons,
+			offset:  msg.offset,
+			hasMore: msg.hasMore,
+			err:     msg.err,
+		}
+		return m, nil
+
+	case actionsMsg:
+		m.listItems = make([]ListItem, len(msg))
+		for i, action := range msg {
+			m.listItems[i] = ActionListItem{Action: action}
+		}
+		m.loading = false
+		m.err = nil
+		m.clampListCursor()
+
+		// Steps may have changed since events were counted
+		m.actionCounts = make(map[string]actionCount)
+		return m, m.loadInspectorTab()
+
+	case exceptionsMsg:
+		m.listItems = make([]ListItem, len(msg))
+		for i, group := range msg {
+			m.listItems[i] = ExceptionListItem{Group: group}
+		}
+		m.loading = false
+		m.err = nil
+		m.clampListCursor()
+
+		// New occurrences may have been captured since they were fetched
+		m.exceptionDetails = make(map[string]exceptionDetail)
+		return m, m.loadInspectorTab()
+
+	case groupsMsg:
+		m.groupTypes = msg.groupTypes
+		m.groupTypePos = msg.typePos
+		m.listItems = make([]ListItem, len(msg.groups))
+		for i, group := range msg.groups {
+			m.listItems[i] = GroupListItem{Group: group}
+		}
+		m.loading = false
+		m.err = nil
+		m.clampListCursor()
+
+		// Members and events may have changed since they were fetched
+		m.groupActivity = make(map[string]groupActivity)
+		return m, m.loadInspectorTab()
+
+	case groupActivityMsg:
+		m.groupActivity[msg.key] = groupActivity{members: msg.members, events: msg.events, err: msg.err}
+		return m, nil
+
+	case groupPivotMsg:
+		// Switch to Groups view after pivot
+		m.pushHistory()
+		m.selectedResource = ResourceGroups
+		m.groupTypes = msg.groupTypes
+		m.groupTypePos = msg.typePos
+		m.listItems = []ListItem{GroupListItem{Group: *msg.group}}
+		m.filteredItems = nil
+		m.listCursor = 0
+		m.inspectorData = *msg.group
+		m.inspectorTab = TabDetails
+		m.focus = FocusPane3
+		m.loading = false
+		m.err = nil
+		return m, m.loadInspectorTab()
+
+	case exceptionDetailMsg:
+		m.exceptionDetails[msg.key] = exceptionDetail{trend: msg.trend, latest: msg.latest, err: msg.err}
+		return m, nil
+
+	case actionCountMsg:
+		m.actionCounts[msg.key] = actionCount{count: msg.count, err: msg.err}
+		return m, nil
+
+	case annotationsMsg:
+		m.setAnnotations(msg)
+		m.setAnnotationListItems()
+		m.loading = false
+		m.err = nil
+		m.clampListCursor()
+		return m, m.loadInspectorTab()
+
+	case chartAnnotationsMsg:
+		m.annotationsLoading = false
+		if msg.err == nil {
+			m.setAnnotations(msg.annotations)
+		}
+		return m, nil
+
+	case annotationCreatedMsg:
+		m.applyAnnotationCreated(msg)
+		return m, nil
+
+	case surveysMsg:
+		m.surveyResponseCounts = msg.counts
+		m.listItems = make([]ListItem, len(msg.surveys))
+		for i, survey := range msg.surveys {
+			responses := -1
+			if msg.counts != nil {
+				responses = msg.counts[survey.ID]
+			}
+			m.listItems[i] = SurveyListItem{Survey: survey, Responses: responses}
+		}
+		m.loading = false
+		m.err = nil
+		m.clampListCursor()
+
+		// New responses may have arrived since they were fetched
+		m.surveyResponses = make(map[string]surveyResponses)
+		return m, m.loadInspectorTab()
+
+	case surveyResponsesMsg:
+		m.applySurveyResponses(msg)
+		return m, nil
+
+	case personRecordingsMsg:
+		m.personRecordings[msg.personUUID] = personRecordings{recordings: msg.recordings, err: msg.err}
+		return m, nil
+
+	case personPropertyHistoryMsg:
+		m.propertyHistories[msg.personUUID] = personPropertyHistory{histories: msg.histories, truncated: msg.truncated, err: msg.err}
+		return m, nil
+
+	case dashboardsMsg:
+		m.listItems = make([]ListItem, len(msg))
+		for i, dashboard := range msg {
+			m.listItems[i] = DashboardListItem{Dashboard: dashboard}
+		}
+		m.loading = false
+		m.err = nil
+		m.clampListCursor()
+
+		// Results may have been refreshed since they were fetched
+		m.dashboards = make(map[int]dashboardDetail)
+		return m, m.loadInspectorTab()
+
+	case dashboardDetailMsg:
+		m.dashboards[msg.dashboardID] = dashboardDetail{
+			dashboard: msg.dashboard,
+			err:       msg.err,
+		}
+		return m, nil
+
+	case schemaMsg:
+		m.setSchemaListItems(msg)
+		m.loading = false
+		m.err = nil
+		m.clampListCursor()
+
+		// Definitions may have changed since properties were fetched
+		m.eventProperties = make(map[string]eventProperties)
+		return m, m.loadInspectorTab()
+
+	case eventPropertiesMsg:
+		m.eventProperties[msg.event] = eventProperties{
+			properties: msg.properties,
+			err:        msg.err,
+		}
+		return m, nil
+
+	case definitionUpdatedMsg:
+		m.applyDefinitionUpdate(msg)
+		return m, nil
+
+	case propertyUpdatedMsg:
+		m.applyPropertyUpdate(msg)
+		return m, nil
+
+	case definitionsHiddenMsg:
+		m.applyDefinitionsHidden(msg)
+		return m, nil
+
+	case membersMsg:
+		return m, m.applyMembers(msg)
+
+	case staticCohortMsg:
+		m.applyStaticCohort(msg)
+		return m, nil
+
+	case personCohortsMsg:
+		m.personCohorts[msg.personUUID] = personCohorts{
+			cohorts: msg.cohorts,
+			err:     msg.err,
+		}
 		return m, nil
 
 	case projectsMsg:
@@ -334,13 +794,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case pivotMsg:
 		// Switch to Persons view after pivot
+		m.pushHistory()
 		m.selectedResource = ResourcePersons
+		m.personSearch = ""
 		m.listItems = []ListItem{PersonListItem{Person: *msg.person}}
+		m.filteredItems = nil
+		m.refreshPersonLayout()
 		m.listCursor = 0
 		m.inspectorData = *msg.person
+		m.inspectorTab = TabDetails
 		m.focus = FocusPane3
 		m.loading = false
 		m.err = nil
+		m.timeline = newPersonTimeline(msg)
+		return m, m.loadInspectorTab()
+
+	case personColumnsSavedMsg:
+		m.personColumnsSaving = false
+		if msg.err != nil {
+			m.showClipboardFeedback("Couldn't save columns: " + msg.err.Error())
+		}
+		if m.personColumnsUnsaved {
+			m.personColumnsUnsaved = false
+			return m, m.queuePersonColumnsSave()
+		}
+		return m, nil
+
+	case personTimelineMsg:
+		m.applyPersonTimeline(msg)
 		return m, nil
 
 	case errorMsg:
@@ -359,10 +840,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.lastDebounceTime = msg.timestamp
 
 			// Trigger fetch
+			if m.selectedResource != msg.resourceType {
+				m.pushHistory()
+			}
 			m.selectedResource = msg.resourceType
+			m.personSearch = ""
 			m.loading = true
 			m.listCursor = 0
 			m.inspectorData = nil
+			m.inspectorTab = TabDetails
 			return m, m.fetchCurrentResource()
 		}
 		// Stale debounce, ignore
@@ -385,6 +871,36 @@ func (m Model) View() string {
 		return m.renderHelpOverlay(m.width, m.height)
 	}
 
+	// Overlay picker if active
+	if m.picker != nil {
+		return m.renderPicker(m.width, m.height)
+	}
+
+	// Overlay trend chart if active
+	if m.trend != nil {
+		return m.renderTrendOverlay(m.width, m.height)
+	}
+
+	// Overlay property breakdown if active
+	if m.breakdown != nil {
+		return m.renderBreakdownOverlay(m.width, m.height)
+	}
+
+	// Overlay Sessions mode if active
+	if m.sessions != nil {
+		return m.renderSessionsOverlay(m.width, m.height)
+	}
+
+	// Overlay funnel mode if active
+	if m.funnelOpen {
+		return m.renderFunnelOverlay(m.width, m.height)
+	}
+
+	// Overlay bulk flag preview/results if active
+	if m.bulk != nil {
+		return m.renderBulkOverlay(m.width, m.height)
+	}
+
 	var content string
 
 	// Check for narrow terminal
@@ -395,13 +911,23 @@ func (m Model) View() string {
 		// Calculate responsive pane widths
 		pane1Width, pane2Width, pane3Width := m.calculatePaneWidths()
 
+		// Leave a line for the history trail once navigated
+		paneHeight := m.height - 3
+		trail := m.renderHistoryTrail(m.width)
+		if trail != "" {
+			paneHeight--
+		}
+
 		// Render each pane
-		pane1 := m.renderResourceSelector(pane1Width, m.height-3)
-		pane2 := m.renderListView(pane2Width, m.height-3)
-		pane3 := m.renderInspector(pane3Width, m.height-3)
+		pane1 := m.renderResourceSelector(pane1Width, paneHeight)
+		pane2 := m.renderListView(pane2Width, paneHeight)
+		pane3 := m.renderInspector(pane3Width, paneHeight)
 
 		// Combine panes horizontally
 		content = lipgloss.JoinHorizontal(lipgloss.Top, pane1, pane2, pane3)
+		if trail != "" {
+			content = trail + "\n" + content
+		}
 	}
 
 	// Add footer with help
@@ -490,7 +1016,10 @@ func (m Model) renderNarrowView() string {
 func (m Model) renderBreadcrumb() string {
 	parts := []string{}
 
-	// Resource type
+	// History trail once navigated, otherwise the resource type
+	if trail := m.renderHistoryTrail(m.width); trail != "" {
+		return trail
+	}
 	parts = append(parts, m.selectedResource.String())
 
 	// Current pane
@@ -513,6 +1042,11 @@ func (m Model) renderFooter() string {
 			styles.KeyStyle.Render("?") + " close help",
 			styles.KeyStyle.Render("Esc") + " close help",
 		}
+	} else if m.prompt != nil {
+		shortcuts = []string{
+			styles.KeyStyle.Render("Enter") + " confirm",
+			styles.KeyStyle.Render("Esc") + " cancel",
+		}
 	} else if m.searchMode {
 		shortcuts = []string{
 			"🔍 " + m.searchInput.Value(),
@@ -540,7 +1074,7 @@ func (m Model) renderFooter() string {
 				// On resource selector
 				shortcuts = append([]string{
 					styles.KeyStyle.Render("j/k") + " navigate",
-					styles.KeyStyle.Render("1/2/3") + " quick select",
+					styles.KeyStyle.Render(fmt.Sprintf("1-%d", styles.Min(9, len(allResources)))) + " quick select",
 					styles.KeyStyle.Render("Tab") + " next",
 				}, shortcuts...)
 			}
@@ -551,6 +1085,9 @@ func (m Model) renderFooter() string {
 						styles.KeyStyle.Render("j/k") + " navigate",
 						styles.KeyStyle.Render("G") + " jump bottom",
 						styles.KeyStyle.Render("/") + " search",
+						styles.KeyStyle.Render("t") + " trend",
+						styles.KeyStyle.Render("f") + " funnel",
+						styles.KeyStyle.Render("v") + " values",
 						styles.KeyStyle.Render("Tab") + " details",
 					}, shortcuts...)
 				} else {
@@ -558,8 +1095,80 @@ func (m Model) renderFooter() string {
 						styles.KeyStyle.Render("j/k") + " navigate",
 						styles.KeyStyle.Render("G") + fmt.Sprintf(" resume (%d new)", m.newEventCount),
 						styles.KeyStyle.Render("/") + " search",
+						styles.KeyStyle.Render("t") + " trend",
+						styles.KeyStyle.Render("f") + " funnel",
+						styles.KeyStyle.Render("v") + " values",
+					}, shortcuts...)
+				}
+				if m.eventFilter != "" {
+					shortcuts = append([]string{
+						styles.KeyStyle.Render("x") + " clear filter",
 					}, shortcuts...)
 				}
+			} else if m.selectedResource == ResourceSchema {
+				shortcuts = append([]string{
+					styles.KeyStyle.Render("j/k") + " navigate",
+					styles.KeyStyle.Render("/") + " search",
+					styles.KeyStyle.Render("L") + " tail live",
+					styles.KeyStyle.Render("e/T/o") + " edit",
+					styles.KeyStyle.Render("Space/A") + fmt.Sprintf(" mark (%d)", len(m.markedDefinitions)),
+					styles.KeyStyle.Render("H") + " hide",
+				}, shortcuts...)
+			} else if m.selectedResource == ResourceActions {
+				shortcuts = append([]string{
+					styles.KeyStyle.Render("j/k") + " navigate",
+					styles.KeyStyle.Render("/") + " search",
+					styles.KeyStyle.Render("L") + " tail live",
+					styles.KeyStyle.Render("d") + fmt.Sprintf(" window (%dd)", m.actionDays),
+				}, shortcuts...)
+			} else if m.selectedResource == ResourceAnnotations {
+				shortcuts = append([]string{
+					styles.KeyStyle.Render("j/k") + " navigate",
+					styles.KeyStyle.Render("/") + " search",
+					styles.KeyStyle.Render("a") + " annotate now",
+				}, shortcuts...)
+			} else if m.selectedResource == ResourceGroups {
+				shortcuts = append([]string{
+					styles.KeyStyle.Render("j/k") + " navigate",
+					styles.KeyStyle.Render("/") + " search",
+					styles.KeyStyle.Render("g") + " next group type",
+				}, shortcuts...)
+			} else if m.selectedResource == ResourceExceptions {
+				shortcuts = append([]string{
+					styles.KeyStyle.Render("j/k") + " navigate",
+					styles.KeyStyle.Render("/") + " search",
+					styles.KeyStyle.Render("L") + " tail live",
+					styles.KeyStyle.Render("d") + fmt.Sprintf(" window (%dd)", m.exceptionDays),
+				}, shortcuts...)
+			} else if m.selectedResource == ResourceSurveys {
+				shortcuts = append([]string{
+					styles.KeyStyle.Render("j/k") + " navigate",
+					styles.KeyStyle.Render("/") + " search",
+					styles.KeyStyle.Render("Ctrl+S") + " export responses",
+				}, shortcuts...)
+			} else if m.selectedResource == ResourceFlags {
+				shortcuts = append([]string{
+					styles.KeyStyle.Render("j/k") + " navigate",
+					styles.KeyStyle.Render("/") + " search",
+					styles.KeyStyle.Render("Space/A") + fmt.Sprintf(" mark (%d)", len(m.markedFlags)),
+					styles.KeyStyle.Render("D/E") + " disable/enable",
+				}, shortcuts...)
+			} else if m.selectedResource == ResourcePersons {
+				shortcuts = append([]string{
+					styles.KeyStyle.Render("j/k") + " navigate",
+					styles.KeyStyle.Render("/") + " search",
+					styles.KeyStyle.Render("Space/A") + fmt.Sprintf(" mark (%d)", len(m.markedPersons)),
+					styles.KeyStyle.Render("C") + " create cohort",
+					styles.KeyStyle.Render("c/s") + " columns/sort",
+					styles.KeyStyle.Render("S") + " sessions",
+				}, shortcuts...)
+			} else if m.selectedResource == ResourceFlagAudit {
+				shortcuts = append([]string{
+					styles.KeyStyle.Render("j/k") + " navigate",
+					styles.KeyStyle.Render("s") + " sort",
+					styles.KeyStyle.Render("d") + fmt.Sprintf(" window (%dd)", m.flagAuditDays),
+					styles.KeyStyle.Render("Ctrl+S") + " export",
+				}, shortcuts...)
 			} else {
 				shortcuts = append([]string{
 					styles.KeyStyle.Render("j/k") + " navigate",
@@ -575,6 +1184,11 @@ func (m Model) renderFooter() string {
 				styles.KeyStyle.Render("y") + " copy",
 				styles.KeyStyle.Render("Esc") + " back",
 			}, shortcuts...)
+			if len(inspectorTabs(m.selectedResource)) > 1 {
+				shortcuts = append([]string{
+					styles.KeyStyle.Render("[/]") + " tabs",
+				}, shortcuts...)
+			}
 		}
 	}
 
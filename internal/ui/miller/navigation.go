@@ -1,6 +1,7 @@
 package miller
 
 import (
+	"strconv"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -9,10 +10,6 @@ import (
 const (
 	// pane1CursorProject indicates the cursor is on the project selector row
 	pane1CursorProject = -1
-
-	// maxResourceCursor is the maximum value for pane1Cursor when on a resource
-	// (0 = Events, 1 = Persons, 2 = Flags)
-	maxResourceCursor = 2
 )
 
 // handleKeyPress handles all keyboard input based on current focus
@@ -20,6 +17,11 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Record interaction for polling pause
 	m.recordInteraction()
 
+	// Picker captures all input (including "?") until an option is chosen
+	if m.picker != nil {
+		return m.handlePickerKeys(msg)
+	}
+
 	// Global help toggle
 	if msg.String() == "?" {
 		m.showHelp = !m.showHelp
@@ -34,6 +36,36 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Trend chart overlay captures all input until closed
+	if m.trend != nil {
+		return m.handleTrendKeys(msg)
+	}
+
+	// Property breakdown overlay captures all input until closed
+	if m.breakdown != nil {
+		return m.handleBreakdownKeys(msg)
+	}
+
+	// Sessions mode captures all input until closed
+	if m.sessions != nil {
+		return m.handleSessionsKeys(msg)
+	}
+
+	// Funnel mode captures all input until closed
+	if m.funnelOpen {
+		return m.handleFunnelKeys(msg)
+	}
+
+	// Bulk flag overlay captures all input until closed
+	if m.bulk != nil {
+		return m.handleBulkKeys(msg)
+	}
+
+	// Text prompt captures all input until confirmed or cancelled
+	if m.prompt != nil {
+		return m.handlePromptKeys(msg)
+	}
+
 	// Check search mode BEFORE global navigation shortcuts
 	// This prevents "l", "h", and other keys from triggering navigation while typing search queries
 	if m.searchMode {
@@ -63,6 +95,11 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.filteredItems = nil
 			return m, nil
 		}
+		// Close an event opened from the person timeline
+		if m.focus == FocusPane3 && m.timelineShown() && m.timeline.opened != nil {
+			m.timeline.opened = nil
+			return m, nil
+		}
 		// Otherwise, go back (move focus left)
 		m.MoveFocusLeft()
 		return m, nil
@@ -84,6 +121,13 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "shift+tab", "left":
 		m.MoveFocusLeft()
 		return m, nil
+
+	case "b", "alt+left":
+		// Browser-style: back to the previous location
+		return m, m.navigateBack()
+
+	case "B", "alt+right":
+		return m, m.navigateForward()
 	}
 
 	// Pane-specific shortcuts
@@ -106,7 +150,7 @@ func (m Model) handlePane1Keys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.MovePane1CursorUp()
 		// Auto-select resource with debounce if cursor moved to a resource
 		if m.pane1Cursor >= 0 {
-			resource := Resource(m.pane1Cursor)
+			resource := allResources[m.pane1Cursor]
 			m.pendingResourceFetch = &resource
 			m.lastDebounceTime = time.Now()
 			return m, startDebounce(resource)
@@ -119,7 +163,7 @@ func (m Model) handlePane1Keys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.MovePane1CursorDown()
 		// Auto-select resource with debounce if cursor moved to a resource
 		if m.pane1Cursor >= 0 {
-			resource := Resource(m.pane1Cursor)
+			resource := allResources[m.pane1Cursor]
 			m.pendingResourceFetch = &resource
 			m.lastDebounceTime = time.Now()
 			return m, startDebounce(resource)
@@ -136,14 +180,11 @@ func (m Model) handlePane1Keys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// No-op if on resource (auto-selection already happened)
 		return m, nil
 
-	case "1":
-		return m, m.selectResource(ResourceEvents)
-
-	case "2":
-		return m, m.selectResource(ResourcePersons)
-
-	case "3":
-		return m, m.selectResource(ResourceFlags)
+	default:
+		// Number keys quick-select resources by their position in Pane 1
+		if n, err := strconv.Atoi(msg.String()); err == nil && n >= 1 && n <= len(allResources) {
+			return m, m.selectResource(allResources[n-1])
+		}
 	}
 
 	return m, nil
@@ -168,7 +209,7 @@ func (m Model) handlePane2Keys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.autoScroll && !m.isAtBottomOfList() {
 			m.autoScroll = false
 		}
-		return m, nil
+		return m, m.loadInspectorTab()
 
 	case "down", "j":
 		m.MoveListCursorDown()
@@ -177,7 +218,7 @@ func (m Model) handlePane2Keys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.autoScroll = true
 			m.newEventCount = 0
 		}
-		return m, nil
+		return m, m.loadInspectorTab()
 
 	case "r":
 		m.loading = true
@@ -189,6 +230,172 @@ func (m Model) handlePane2Keys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m.handlePivot()
 		}
 		return m, nil
+
+	case "g":
+		// Pivot to one of the event's groups, or list the next group type
+		switch m.selectedResource {
+		case ResourceEvents:
+			return m, m.pivotToGroup()
+		case ResourceGroups:
+			return m, m.cycleGroupType()
+		}
+		return m, nil
+
+	case "t":
+		// Trend chart of the selected event: only available for Events
+		if m.selectedResource == ResourceEvents {
+			return m, m.openTrend()
+		}
+		return m, nil
+
+	case "f":
+		// Add the selected event as a funnel step: only available for Events
+		if m.selectedResource == ResourceEvents {
+			m.addFunnelStep()
+		}
+		return m, nil
+
+	case "v":
+		// Breakdown of a property's values: only available for Events
+		if m.selectedResource == ResourceEvents {
+			return m, m.openPropertyBreakdown()
+		}
+		return m, nil
+
+	case "S":
+		// Group events by session: available for Events and Persons
+		return m, m.openSessions()
+
+	case "L":
+		// Tail live events with the selected definition's name, or matching
+		// the selected action or exception
+		switch m.selectedResource {
+		case ResourceSchema:
+			return m, m.tailSelectedDefinition()
+		case ResourceActions:
+			return m, m.tailSelectedAction()
+		case ResourceExceptions:
+			return m, m.tailSelectedException()
+		}
+		return m, nil
+
+	case "e", "T", "o", "V", "H":
+		// Edit definition metadata: only available for Schema
+		if m.selectedResource == ResourceSchema {
+			return m, m.editDefinition(msg.String())
+		}
+		return m, nil
+
+	case "a":
+		// Create an annotation: only available for Annotations
+		if m.selectedResource == ResourceAnnotations {
+			m.startAnnotation()
+		}
+		return m, nil
+
+	case "F":
+		// Open funnel mode without adding a step
+		if m.funnel == nil {
+			m.funnel = &funnelView{}
+		}
+		m.funnelOpen = true
+		return m, nil
+
+	case " ":
+		// Mark flag for a bulk operation, or person for a new cohort
+		switch m.selectedResource {
+		case ResourceFlags:
+			m.toggleFlagMark()
+			return m, m.loadInspectorTab()
+		case ResourcePersons:
+			m.togglePersonMark()
+			return m, m.loadInspectorTab()
+		case ResourceSchema:
+			m.toggleDefinitionMark()
+			return m, nil
+		}
+		return m, nil
+
+	case "A":
+		// Mark all visible flags or persons
+		switch m.selectedResource {
+		case ResourceFlags:
+			m.toggleAllFlagMarks()
+		case ResourcePersons:
+			m.toggleAllPersonMarks()
+		case ResourceSchema:
+			m.toggleAllDefinitionMarks()
+		}
+		return m, nil
+
+	case "x":
+		// Clear the live event filter: only available for Events
+		if m.selectedResource == ResourceEvents && m.eventFilter != "" {
+			m.eventFilter = ""
+			m.eventCondition = ""
+			m.loading = true
+			return m, m.fetchCurrentResource()
+		}
+		return m, nil
+
+	case "C":
+		// Create a static cohort from marked persons: only available for Persons
+		if m.selectedResource == ResourcePersons {
+			m.startStaticCohort()
+		}
+		return m, nil
+
+	case "D", "E":
+		// Bulk disable/enable marked flags: only available for Flags
+		if m.selectedResource == ResourceFlags {
+			m.startBulkToggle(msg.String() == "E")
+		}
+		return m, nil
+
+	case "s":
+		// Cycle the Flag Audit sort column, or pick the Persons sort column
+		switch m.selectedResource {
+		case ResourceFlagAudit:
+			m.cycleFlagAuditSort()
+		case ResourcePersons:
+			m.openPersonSortPicker()
+		}
+		return m, nil
+
+	case "c":
+		// Show or hide person property columns: only available for Persons
+		if m.selectedResource == ResourcePersons {
+			m.openPersonColumnPicker()
+		}
+		return m, nil
+
+	case "d":
+		// Cycle the Flag Audit evaluation window, the Actions match count
+		// window, or the Exceptions window
+		switch m.selectedResource {
+		case ResourceFlagAudit:
+			m.cycleFlagAuditDays()
+			m.loading = true
+			return m, m.fetchCurrentResource()
+		case ResourceExceptions:
+			m.cycleExceptionDays()
+			m.loading = true
+			return m, m.fetchCurrentResource()
+		case ResourceActions:
+			m.cycleActionDays()
+			return m, m.loadInspectorTab()
+		}
+		return m, nil
+
+	case "ctrl+s":
+		// Export the Flag Audit report, or the selected survey's responses
+		switch m.selectedResource {
+		case ResourceFlagAudit:
+			m.exportFlagAudit()
+		case ResourceSurveys:
+			return m, m.exportSurveyResponses()
+		}
+		return m, nil
 	}
 
 	return m, nil
@@ -198,13 +405,69 @@ func (m Model) handlePane2Keys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m Model) handlePane3Keys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "j", "down":
+		// Move between recordings in the Recordings tab, or events in the
+		// person timeline, otherwise scroll
+		if m.activeInspectorTab() == TabRecordings {
+			m.moveRecordingCursor(1)
+			return m, nil
+		}
+		if m.activeInspectorTab() == TabHistory && m.selectedResource == ResourcePersons {
+			m.movePropertyCursor(1)
+			return m, nil
+		}
+		if m.timelineShown() && m.timeline.opened == nil {
+			m.moveTimelineCursor(1)
+			return m, nil
+		}
 		m.inspectorViewport.LineDown(1)
 		return m, nil
 
 	case "k", "up":
+		if m.activeInspectorTab() == TabRecordings {
+			m.moveRecordingCursor(-1)
+			return m, nil
+		}
+		if m.activeInspectorTab() == TabHistory && m.selectedResource == ResourcePersons {
+			m.movePropertyCursor(-1)
+			return m, nil
+		}
+		if m.timelineShown() && m.timeline.opened == nil {
+			m.moveTimelineCursor(-1)
+			return m, nil
+		}
 		m.inspectorViewport.LineUp(1)
 		return m, nil
 
+	case "enter":
+		// Open the selected event of the person timeline, or load more
+		if m.timelineShown() && m.timeline.opened == nil {
+			return m, m.openTimelineEvent()
+		}
+		return m, nil
+
+	case "backspace":
+		// Return from an event opened from the person timeline
+		if m.timelineShown() {
+			m.timeline.opened = nil
+		}
+		return m, nil
+
+	case "J", "K":
+		// Scroll the person's details above the timeline
+		if m.timelineShown() {
+			if msg.String() == "J" {
+				m.scrollTimelineDetails(5)
+			} else {
+				m.scrollTimelineDetails(-5)
+			}
+		}
+		return m, nil
+
+	case "u":
+		// Copy the selected recording's replay URL: only available for Persons
+		m.copyReplayURL()
+		return m, nil
+
 	case "ctrl+d":
 		m.inspectorViewport.HalfPageDown()
 		return m, nil
@@ -223,6 +486,26 @@ func (m Model) handlePane3Keys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.jsonFoldAll()
 		return m, nil
 
+	case "]":
+		m.cycleInspectorTab(1)
+		return m, m.loadInspectorTab()
+
+	case "[":
+		m.cycleInspectorTab(-1)
+		return m, m.loadInspectorTab()
+
+	case "n":
+		// Load more of the person timeline, or the next page of members
+		// (Cohorts only)
+		if m.timelineShown() {
+			return m, m.loadMoreTimeline()
+		}
+		return m, m.pageCohortMembers(1)
+
+	case "N":
+		// Previous page of members: only available for Cohorts
+		return m, m.pageCohortMembers(-1)
+
 	case "y":
 		// Copy raw JSON
 		if m.inspectorData != nil {
@@ -257,6 +540,87 @@ func (m Model) handlePane3Keys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m.handlePivot()
 		}
 		return m, nil
+
+	case "g":
+		// Pivot to one of the event's groups, or list the next group type
+		switch m.selectedResource {
+		case ResourceEvents:
+			return m, m.pivotToGroup()
+		case ResourceGroups:
+			return m, m.cycleGroupType()
+		}
+		return m, nil
+
+	case "t":
+		// Trend chart of the selected event: only available for Events
+		if m.selectedResource == ResourceEvents {
+			return m, m.openTrend()
+		}
+		return m, nil
+
+	case "f":
+		// Add the selected event as a funnel step: only available for Events
+		if m.selectedResource == ResourceEvents {
+			m.addFunnelStep()
+		}
+		return m, nil
+
+	case "v":
+		// Breakdown of a property's values: only available for Events
+		if m.selectedResource == ResourceEvents {
+			return m, m.openPropertyBreakdown()
+		}
+		return m, nil
+
+	case "S":
+		// Group events by session: available for Events and Persons
+		return m, m.openSessions()
+
+	case "L":
+		// Tail live events with the selected definition's name, or matching
+		// the selected action or exception
+		switch m.selectedResource {
+		case ResourceSchema:
+			return m, m.tailSelectedDefinition()
+		case ResourceActions:
+			return m, m.tailSelectedAction()
+		case ResourceExceptions:
+			return m, m.tailSelectedException()
+		}
+		return m, nil
+
+	case "e", "T", "o", "V", "H":
+		// Edit definition metadata: only available for Schema
+		if m.selectedResource == ResourceSchema {
+			return m, m.editDefinition(msg.String())
+		}
+		// Open the selected recording's replay: only available for Persons
+		if msg.String() == "o" && m.selectedResource == ResourcePersons {
+			m.openReplay()
+		}
+		return m, nil
+
+	case "ctrl+s":
+		// Export the selected survey's responses: only available for Surveys
+		if m.selectedResource == ResourceSurveys {
+			return m, m.exportSurveyResponses()
+		}
+		return m, nil
+
+	case "a":
+		// Create an annotation: only available for Annotations
+		if m.selectedResource == ResourceAnnotations {
+			m.startAnnotation()
+		}
+		return m, nil
+
+	case "F":
+		// Open funnel mode without adding a step
+		if m.funnel == nil {
+			m.funnel = &funnelView{}
+		}
+		m.funnelOpen = true
+		return m, nil
 	}
 
 	return m, nil
@@ -265,12 +629,20 @@ func (m Model) handlePane3Keys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 // selectResource handles direct resource selection via number keys (1, 2, 3)
 // and returns the command to fetch the resource data
 func (m *Model) selectResource(resource Resource) tea.Cmd {
+	m.pushHistory()
+	return m.showResource(resource)
+}
+
+// showResource switches to a resource without recording the location left
+func (m *Model) showResource(resource Resource) tea.Cmd {
 	m.pane1Cursor = int(resource)
 	m.selectedResource = resource
 	m.pendingResourceFetch = nil // Cancel any pending debounce
+	m.personSearch = ""
 	m.loading = true
 	m.listCursor = 0
 	m.inspectorData = nil
+	m.inspectorTab = TabDetails
 	return m.fetchCurrentResource()
 }
 
@@ -283,11 +655,23 @@ func (m *Model) MovePane1CursorUp() {
 
 // MovePane1CursorDown moves cursor down in Pane 1 (project + resources)
 func (m *Model) MovePane1CursorDown() {
-	if m.pane1Cursor < maxResourceCursor {
+	// pane1Cursor indexes allResources when on a resource
+	if m.pane1Cursor < len(allResources)-1 {
 		m.pane1Cursor++
 	}
 }
 
+// projectLocation returns the selected project's timezone, UTC until the
+// projects are loaded
+func (m Model) projectLocation() *time.Location {
+	for _, project := range m.availableProjects {
+		if project.ID == m.selectedProjectID {
+			return project.Location()
+		}
+	}
+	return time.UTC
+}
+
 // handleProjectSwitch handles Enter key on project selector
 func (m Model) handleProjectSwitch() (tea.Model, tea.Cmd) {
 	if !m.projectsLoaded || len(m.availableProjects) == 0 {
@@ -310,6 +694,32 @@ func (m Model) handleProjectSwitch() (tea.Model, tea.Cmd) {
 	// Update client project ID
 	m.client.SetProjectID(m.selectedProjectID)
 
+	// Locations in the previous project can't be returned to
+	m.clearHistory()
+
+	// Annotations are per project, refetched for the next chart
+	m.annotations = nil
+	m.annotationsLoading = false
+
+	// Cohort names are per project, refetched for the next condition shown
+	m.cohortNames = nil
+	m.cohortNamesLoading = false
+
+	// Event names are per project, refetched for the next picker
+	m.eventNames = nil
+
+	// The project may be in another organization, with other members
+	m.members = nil
+	m.ownerPickerWait = false
+	m.markedDefinitions = make(map[string]bool)
+
+	// Persons marked for a cohort belong to the previous project
+	m.markedPersons = make(map[string]bool)
+
+	// The live Events filter, e.g. of an action, is the previous project's
+	m.eventFilter = ""
+	m.eventCondition = ""
+
 	// Refetch current resource with new project
 	m.loading = true
 	m.listCursor = 0
@@ -0,0 +1,273 @@
+package miller
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/config"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxPersonColumnLen is the truncation length of person column values
+const maxPersonColumnLen = 24
+
+// personColumn is a column of the Persons list with its display width
+type personColumn struct {
+	key   string
+	width int
+}
+
+// personLayout is the column layout shared by the rows of the Persons list
+type personLayout struct {
+	nameWidth int
+	columns   []personColumn
+	sortBy    string // column the list is sorted by, "" for the server's order
+	sortDesc  bool
+}
+
+// personColumnsSavedMsg is sent when the column sets have been written to the config file
+type personColumnsSavedMsg struct {
+	err error
+}
+
+// savePersonColumns writes the column sets of all projects to the config
+// file. The file is reloaded first so that runtime overrides, such as the
+// --repo flag, aren't written with them.
+func savePersonColumns(columnSets map[int][]string) tea.Cmd {
+	return func() tea.Msg {
+		cfg, err := config.Load()
+		if err != nil {
+			return personColumnsSavedMsg{err: err}
+		}
+		cfg.PersonColumns = columnSets
+		return personColumnsSavedMsg{err: config.Save(cfg)}
+	}
+}
+
+// queuePersonColumnsSave saves the column sets, or once the save in
+// progress is done so that an older set can't be written last
+func (m *Model) queuePersonColumnsSave() tea.Cmd {
+	if m.cfg == nil {
+		return nil
+	}
+	if m.personColumnsSaving {
+		m.personColumnsUnsaved = true
+		return nil
+	}
+	m.personColumnsSaving = true
+	return savePersonColumns(maps.Clone(m.personColumnSets))
+}
+
+// personColumns returns the columns shown for the current project
+func (m Model) personColumns() []string {
+	if columns, ok := m.personColumnSets[m.client.GetProjectID()]; ok {
+		return columns
+	}
+	return utils.DefaultPersonColumns
+}
+
+// togglePersonColumn shows a column if hidden or hides it if shown, and
+// persists the project's column set
+func (m *Model) togglePersonColumn(key string) tea.Cmd {
+	var columns []string
+	shown := false
+	for _, column := range m.personColumns() {
+		if column == key {
+			shown = true
+			continue
+		}
+		columns = append(columns, column)
+	}
+	if !shown {
+		columns = append(columns, key)
+	}
+	if shown && m.personSortBy == key {
+		// The list can't stay sorted by a hidden column
+		m.personSortBy = ""
+	}
+
+	m.personColumnSets[m.client.GetProjectID()] = columns
+	m.refreshPersonLayout()
+
+	return m.queuePersonColumnsSave()
+}
+
+// openPersonColumnPicker lets the user show or hide columns, offering the
+// shown columns first, then suggestions and the listed persons' properties
+func (m *Model) openPersonColumnPicker() {
+	shown := make(map[string]bool)
+	var options []string
+	for _, column := range m.personColumns() {
+		shown[column] = true
+		options = append(options, "✓ "+column)
+	}
+
+	candidates := append([]string{}, utils.SuggestedPersonColumns...)
+	var keys []string
+	seen := make(map[string]bool)
+	for _, item := range m.listItems {
+		if p, ok := item.(PersonListItem); ok {
+			for key := range p.Person.Properties {
+				if !seen[key] {
+					seen[key] = true
+					keys = append(keys, key)
+				}
+			}
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range append(candidates, keys...) {
+		if !shown[key] {
+			shown[key] = true
+			options = append(options, "  "+key)
+		}
+	}
+
+	m.openPicker("Person columns (Enter to show/hide)", options, func(m *Model, option string) tea.Cmd {
+		cmd := m.togglePersonColumn(strings.TrimPrefix(strings.TrimPrefix(option, "✓ "), "  "))
+		m.openPersonColumnPicker()
+		return cmd
+	})
+}
+
+// openPersonSortPicker lets the user sort the list by the name or a shown
+// column; picking the current sort column reverses the order
+func (m *Model) openPersonSortPicker() {
+	options := append([]string{utils.PersonColumnName}, m.personColumns()...)
+	m.openPicker("Sort persons by", options, func(m *Model, column string) tea.Cmd {
+		if column == m.personSortBy {
+			m.personSortDesc = !m.personSortDesc
+		} else {
+			m.personSortBy = column
+			// Most recent first for times, alphabetical otherwise
+			m.personSortDesc = column == utils.PersonColumnLastSeen || column == utils.PersonColumnCreated
+		}
+		m.refreshPersonLayout()
+		m.listCursor = 0
+		m.updateInspectorFromCursor()
+		return m.loadInspectorTab()
+	})
+}
+
+// refreshPersonLayout sorts the listed persons by the sort column and
+// lays out the shown columns to fit their values
+func (m *Model) refreshPersonLayout() {
+	if m.personSortBy != "" {
+		for _, items := range [][]ListItem{m.listItems, m.filteredItems} {
+			sort.SliceStable(items, func(i, j int) bool {
+				a, aOK := items[i].(PersonListItem)
+				b, bOK := items[j].(PersonListItem)
+				return aOK && bOK && utils.PersonColumnLess(a.Person, b.Person, m.personSortBy, m.personSortDesc)
+			})
+		}
+	}
+
+	layout := &personLayout{sortBy: m.personSortBy, sortDesc: m.personSortDesc}
+	for _, key := range m.personColumns() {
+		layout.columns = append(layout.columns, personColumn{key: key, width: utf8.RuneCountInString(layout.header(key))})
+	}
+
+	layout.nameWidth = utf8.RuneCountInString(layout.header(utils.PersonColumnName))
+
+	now := time.Now()
+	for _, item := range m.listItems {
+		p, ok := item.(PersonListItem)
+		if !ok {
+			continue
+		}
+		layout.nameWidth = styles.Max(layout.nameWidth, utf8.RuneCountInString(personDisplayName(p.Person)))
+		for i, column := range layout.columns {
+			layout.columns[i].width = styles.Max(column.width, utf8.RuneCountInString(utils.PersonColumnValue(p.Person, column.key, now)))
+		}
+	}
+	layout.nameWidth = styles.Min(layout.nameWidth, maxPersonNameLen)
+	for i, column := range layout.columns {
+		layout.columns[i].width = styles.Min(column.width, maxPersonColumnLen)
+	}
+
+	for _, items := range [][]ListItem{m.listItems, m.filteredItems} {
+		for i, item := range items {
+			if p, ok := item.(PersonListItem); ok {
+				p.Layout = layout
+				items[i] = p
+			}
+		}
+	}
+	m.personLayout = layout
+}
+
+// personDisplayName returns a person's name for the list
+func personDisplayName(p client.Person) string {
+	if p.Name == "" {
+		return "(no name)"
+	}
+	return p.Name
+}
+
+// header returns a column's header, marked with the sort direction if the
+// list is sorted by it
+func (l *personLayout) header(key string) string {
+	label := utils.PersonColumnLabel(key)
+	if key == utils.PersonColumnName {
+		label = "Name"
+	}
+	if key != l.sortBy {
+		return label
+	}
+	if l.sortDesc {
+		return label + " ▼"
+	}
+	return label + " ▲"
+}
+
+// fit returns the columns that fit in width after the name
+func (l *personLayout) fit(width int) []personColumn {
+	used := l.nameWidth
+	for i, column := range l.columns {
+		used += 2 + column.width
+		if used > width {
+			return l.columns[:i]
+		}
+	}
+	return l.columns
+}
+
+// row lays out a person's name and column values, padded to the column widths
+func (l *personLayout) row(p client.Person, width int) string {
+	now := time.Now()
+	cells := []string{padCell(personDisplayName(p), l.nameWidth)}
+	for _, column := range l.fit(width) {
+		cells = append(cells, padCell(utils.PersonColumnValue(p, column.key, now), column.width))
+	}
+	return strings.Join(cells, "  ")
+}
+
+// renderHeader renders the column headers aligned with the rows, which are
+// padded and prefixed with the selection and mark indicators
+func (l *personLayout) renderHeader(width int) string {
+	cells := []string{padCell(l.header(utils.PersonColumnName), l.nameWidth)}
+	for _, column := range l.fit(width - 8) {
+		cells = append(cells, padCell(l.header(column.key), column.width))
+	}
+	return styles.DimTextStyle.Render(strings.Repeat(" ", 6) + strings.Join(cells, "  "))
+}
+
+// padCell truncates or pads a value to a column width
+func padCell(value string, width int) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if runes := []rune(value); len(runes) > width {
+		if width <= 3 {
+			value = string(runes[:width])
+		} else {
+			value = string(runes[:width-3]) + "..."
+		}
+	}
+	return fmt.Sprintf("%-*s", width, value)
+}
@@ -0,0 +1,190 @@
+package miller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxPropertyUpdates is the number of most recent property updating events
+// the history is reconstructed from
+const maxPropertyUpdates = 1000
+
+// personPropertyHistory holds the reconstructed history of a person's properties
+type personPropertyHistory struct {
+	histories []utils.PropertyHistory
+	truncated bool // true if older updating events weren't fetched
+	loading   bool
+	err       error
+}
+
+// personPropertyHistoryMsg is sent when a person's property history has been fetched
+type personPropertyHistoryMsg struct {
+	personUUID string
+	histories  []utils.PropertyHistory
+	truncated  bool
+	err        error
+}
+
+// fetchPersonPropertyHistory fetches the events that set or unset a
+// person's properties and reconstructs the history of each property
+func fetchPersonPropertyHistory(c client.PostHogClient, personUUID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		updates, err := c.GetPersonPropertyUpdates(ctx, personUUID, maxPropertyUpdates)
+		if err != nil {
+			return personPropertyHistoryMsg{personUUID: personUUID, err: err}
+		}
+		return personPropertyHistoryMsg{
+			personUUID: personUUID,
+			histories:  utils.BuildPropertyHistory(updates),
+			truncated:  len(updates) >= maxPropertyUpdates,
+		}
+	}
+}
+
+// inspectedPropertyHistory returns the loaded property history of the inspected person
+func (m Model) inspectedPropertyHistory() []utils.PropertyHistory {
+	person, ok := m.inspectorData.(client.Person)
+	if !ok {
+		return nil
+	}
+	return m.propertyHistories[personUUID(person)].histories
+}
+
+// movePropertyCursor moves the property selection in the History tab by delta
+func (m *Model) movePropertyCursor(delta int) {
+	histories := m.inspectedPropertyHistory()
+	if len(histories) == 0 {
+		return
+	}
+	cursor := styles.Min(m.propertyCursor, len(histories)-1) + delta
+	m.propertyCursor = styles.Max(0, styles.Min(cursor, len(histories)-1))
+}
+
+// renderPersonHistoryScrollable renders the person's properties, most
+// recently changed first, with the selected property's timeline of values
+func (m Model) renderPersonHistoryScrollable(width, height int) string {
+	person, ok := m.inspectorData.(client.Person)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid person data")
+	}
+
+	var lines []string
+	cursorLine := 0
+
+	loaded, ok := m.propertyHistories[personUUID(person)]
+	switch {
+	case !ok || loaded.loading:
+		lines = append(lines, m.spinner.View()+" Loading property history...")
+	case loaded.err != nil:
+		lines = append(lines, styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", loaded.err)))
+	case len(loaded.histories) == 0:
+		lines = append(lines, styles.DimTextStyle.Render("No $set, $set_once or $unset events for this person"))
+	default:
+		lines = append(lines, styles.DimTextStyle.Render(fmt.Sprintf("%d properties changed · j/k select a property", len(loaded.histories))))
+		if loaded.truncated {
+			// The first value seen may not be the original one, e.g. of $set_once
+			lines = append(lines, styles.WarningTextStyle.Render(fmt.Sprintf(
+				"Only the %d most recent updating events were read, earlier values are missing", maxPropertyUpdates)))
+		}
+		lines = append(lines, "")
+
+		// Align the values of all properties
+		keyWidth := 0
+		for _, history := range loaded.histories {
+			keyWidth = styles.Max(keyWidth, utf8.RuneCountInString(history.Key))
+		}
+		keyWidth = styles.Min(keyWidth, maxPersonColumnLen)
+
+		now := time.Now()
+		cursor := styles.Min(m.propertyCursor, len(loaded.histories)-1)
+		for i, history := range loaded.histories {
+			if i == cursor {
+				cursorLine = len(lines)
+			}
+			lines = append(lines, renderPropertySummary(history, keyWidth, i == cursor, now, width-8))
+			if i == cursor {
+				lines = append(lines, renderPropertyTimeline(history, width-8)...)
+			}
+		}
+	}
+
+	// Build full content and update viewport, keeping the cursor in view
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+	m.inspectorViewport.SetYOffset(cursorLine - m.inspectorViewport.Height/2)
+
+	return m.inspectorViewport.View()
+}
+
+// renderPropertySummary renders a property's current value and how often
+// and when it last changed
+func renderPropertySummary(history utils.PropertyHistory, keyWidth int, selected bool, now time.Time, width int) string {
+	latest := history.Latest()
+	changes := "1 change"
+	if len(history.Changes) != 1 {
+		changes = fmt.Sprintf("%d changes", len(history.Changes))
+	}
+	stats := fmt.Sprintf(" · %s · %s ago", changes, utils.FormatDuration(now.Sub(latest.Timestamp)))
+
+	value := formatPropertyValue(latest.Value)
+	valueWidth := styles.Max(10, width-keyWidth-len(stats)-4)
+	if utf8.RuneCountInString(value) > valueWidth {
+		value = padCell(value, valueWidth)
+	}
+	line := styles.JSONKeyStyle.Render(padCell(history.Key, keyWidth)) + "  " + value + styles.DimTextStyle.Render(stats)
+	if selected {
+		return styles.HighlightTextStyle.Render("▶ ") + line
+	}
+	return "  " + line
+}
+
+// renderPropertyTimeline renders a property's values, newest first, with the
+// event and time that set them
+func renderPropertyTimeline(history utils.PropertyHistory, width int) []string {
+	// Align the events that set the values
+	valueWidth := 0
+	for _, change := range history.Changes {
+		valueWidth = styles.Max(valueWidth, utf8.RuneCountInString(formatPropertyValue(change.Value)))
+	}
+	valueWidth = styles.Min(valueWidth, styles.Max(10, width/3))
+
+	var lines []string
+	for i := len(history.Changes) - 1; i >= 0; i-- {
+		change := history.Changes[i]
+		when := client.FormatEventTime(change.Timestamp.Local())
+		source := fmt.Sprintf("  %-9s %s", change.Operation, change.Event)
+		lines = append(lines, "    "+styles.DimTextStyle.Render(when)+"  "+padCell(formatPropertyValue(change.Value), valueWidth)+styles.DimTextStyle.Render(source))
+	}
+	return append(lines, "")
+}
+
+// formatPropertyValue formats a property value for display, as JSON unless
+// it's a string
+func formatPropertyValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "(unset)"
+	case string:
+		return v
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(encoded)
+	}
+}
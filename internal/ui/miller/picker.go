@@ -0,0 +1,145 @@
+package miller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// picker is a fuzzy-filtered list overlay for choosing one option, e.g. a
+// property key to break a chart down by. onSelect is called with the choice.
+type picker struct {
+	title    string
+	options  []string
+	filter   textinput.Model
+	cursor   int
+	onSelect func(m *Model, option string) tea.Cmd
+}
+
+// openPicker shows a picker over the given options
+func (m *Model) openPicker(title string, options []string, onSelect func(m *Model, option string) tea.Cmd) {
+	filter := textinput.New()
+	filter.Placeholder = "Type to filter..."
+	filter.Prompt = "🔍 "
+	filter.PromptStyle = styles.SearchPromptStyle
+	filter.TextStyle = styles.SearchTextStyle
+	filter.Focus()
+
+	m.picker = &picker{title: title, options: options, filter: filter, onSelect: onSelect}
+}
+
+// matches returns the options matching the current filter
+func (p *picker) matches() []string {
+	query := strings.ToLower(p.filter.Value())
+	if query == "" {
+		return p.options
+	}
+
+	var matched []string
+	for _, option := range p.options {
+		if fuzzyMatch(query, strings.ToLower(option)) {
+			matched = append(matched, option)
+		}
+	}
+	return matched
+}
+
+// fuzzyMatch reports whether all characters of query appear in s in order
+func fuzzyMatch(query, s string) bool {
+	for _, r := range query {
+		i := strings.IndexRune(s, r)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(string(r)):]
+	}
+	return true
+}
+
+// handlePickerKeys handles keyboard input while a picker is shown
+func (m Model) handlePickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	matches := m.picker.matches()
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc":
+		m.picker = nil
+		return m, nil
+
+	case "up", "ctrl+p", "ctrl+k":
+		if m.picker.cursor > 0 {
+			m.picker.cursor--
+		}
+		return m, nil
+
+	case "down", "ctrl+n", "ctrl+j":
+		if m.picker.cursor < len(matches)-1 {
+			m.picker.cursor++
+		}
+		return m, nil
+
+	case "enter":
+		if m.picker.cursor >= len(matches) {
+			return m, nil
+		}
+		option := matches[m.picker.cursor]
+		onSelect := m.picker.onSelect
+		m.picker = nil
+		return m, onSelect(&m, option)
+	}
+
+	var cmd tea.Cmd
+	m.picker.filter, cmd = m.picker.filter.Update(msg)
+	m.picker.cursor = 0
+	return m, cmd
+}
+
+// renderPicker renders the picker overlay
+func (m Model) renderPicker(width, height int) string {
+	var sb strings.Builder
+
+	sb.WriteString(styles.TitleStyle.Render(m.picker.title))
+	sb.WriteString("\n\n")
+	sb.WriteString(m.picker.filter.View())
+	sb.WriteString("\n\n")
+
+	matches := m.picker.matches()
+	visible := styles.Max(5, height-14)
+
+	start := styles.Max(0, m.picker.cursor-visible/2)
+	end := styles.Min(len(matches), start+visible)
+	start = styles.Max(0, end-visible)
+
+	for i := start; i < end; i++ {
+		if i == m.picker.cursor {
+			sb.WriteString(styles.SelectedListItemStyle.Render("▶ " + matches[i]))
+		} else {
+			sb.WriteString(styles.ListItemStyle.Render("  " + matches[i]))
+		}
+		sb.WriteString("\n")
+	}
+	if len(matches) == 0 {
+		sb.WriteString(styles.DimTextStyle.Render("No matches"))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(styles.DimTextStyle.Render(fmt.Sprintf("%d of %d", len(matches), len(m.picker.options))))
+	sb.WriteString("\n")
+	sb.WriteString(styles.KeyStyle.Render("↑/↓") + " navigate • " + styles.KeyStyle.Render("Enter") + " select • " + styles.KeyStyle.Render("Esc") + " cancel")
+
+	overlayStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorPrimary).
+		Padding(1, 2).
+		Width(width - 4).
+		Height(height - 4)
+
+	return overlayStyle.Render(sb.String())
+}
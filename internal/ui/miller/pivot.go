@@ -2,12 +2,39 @@ package miller
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// personTimelinePageSize is the number of a person's events loaded at a time
+const personTimelinePageSize = 20
+
+// personTimeline is the navigable list of recent events of the person pivoted
+// to, shown below their properties in the inspector
+type personTimeline struct {
+	distinctID  string
+	events      []client.Event // newest first
+	cursor      int            // len(events) selects the "load more" row
+	hasMore     bool
+	loading     bool
+	err         error
+	opened      *client.Event // event opened with Enter, shown instead of the timeline
+	propsScroll int           // first line of the person's details shown
+}
+
+// personTimelineMsg is sent when another page of a person's events has been fetched
+type personTimelineMsg struct {
+	distinctID string
+	events     []client.Event
+	err        error
+}
+
 // handlePivot handles the pivot from event to person
 func (m Model) handlePivot() (tea.Model, tea.Cmd) {
 	if len(m.listItems) == 0 || m.listCursor >= len(m.listItems) {
@@ -36,16 +63,209 @@ func fetchPersonByDistinctID(c client.PostHogClient, distinctID string) tea.Cmd
 			return errorMsg{err: err}
 		}
 
-		events, eventsErr := c.GetPersonEvents(ctx, distinctID, 20)
+		events, eventsErr := c.GetPersonEvents(ctx, distinctID, personTimelinePageSize, 0)
 		if eventsErr != nil {
 			// Don't fail completely if events fail, continue with empty events
 			events = []client.Event{}
 		}
 
 		return pivotMsg{
+			distinctID:  distinctID,
 			person:      person,
 			events:      events,
 			eventsError: eventsErr,
 		}
 	}
 }
+
+// fetchPersonTimeline fetches a page of a person's events, skipping the
+// offset most recent ones
+func fetchPersonTimeline(c client.PostHogClient, distinctID string, offset int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		events, err := c.GetPersonEvents(ctx, distinctID, personTimelinePageSize, offset)
+		return personTimelineMsg{distinctID: distinctID, events: events, err: err}
+	}
+}
+
+// newPersonTimeline creates the timeline of the person pivoted to
+func newPersonTimeline(msg pivotMsg) *personTimeline {
+	return &personTimeline{
+		distinctID: msg.distinctID,
+		events:     msg.events,
+		hasMore:    len(msg.events) == personTimelinePageSize,
+		err:        msg.eventsError,
+	}
+}
+
+// timelineShown reports whether the inspector shows the person timeline,
+// i.e. the person pivoted to is inspected in the Details tab
+func (m Model) timelineShown() bool {
+	if m.timeline == nil || m.selectedResource != ResourcePersons || m.activeInspectorTab() != TabDetails {
+		return false
+	}
+	person, ok := m.inspectorData.(client.Person)
+	if !ok {
+		return false
+	}
+	for _, id := range person.DistinctIDs {
+		if id == m.timeline.distinctID {
+			return true
+		}
+	}
+	return false
+}
+
+// loadMoreTimeline fetches the next page of the timeline's events, or
+// retries after an error
+func (m *Model) loadMoreTimeline() tea.Cmd {
+	t := m.timeline
+	if t.loading || (!t.hasMore && t.err == nil) {
+		return nil
+	}
+	t.loading = true
+	t.err = nil
+	return fetchPersonTimeline(m.client, t.distinctID, len(t.events))
+}
+
+// applyPersonTimeline appends a fetched page of events to the timeline,
+// skipping events already listed if new ones shifted the pages
+func (m *Model) applyPersonTimeline(msg personTimelineMsg) {
+	t := m.timeline
+	if t == nil || msg.distinctID != t.distinctID {
+		return
+	}
+	t.loading = false
+	t.err = msg.err
+	if msg.err != nil {
+		return
+	}
+
+	seen := make(map[string]bool, len(t.events))
+	for _, event := range t.events {
+		seen[event.UUID] = true
+	}
+	for _, event := range msg.events {
+		if !seen[event.UUID] {
+			t.events = append(t.events, event)
+		}
+	}
+	t.hasMore = len(msg.events) == personTimelinePageSize
+}
+
+// moveTimelineCursor moves the timeline cursor by delta, onto the "load
+// more" row past the last event if there are more
+func (m *Model) moveTimelineCursor(delta int) {
+	t := m.timeline
+	last := len(t.events) - 1
+	if t.hasMore || t.err != nil {
+		last = len(t.events)
+	}
+	t.cursor = styles.Max(0, styles.Min(t.cursor+delta, last))
+}
+
+// scrollTimelineDetails scrolls the person's details above the timeline by delta lines
+func (m *Model) scrollTimelineDetails(delta int) {
+	last := 0
+	if person, ok := m.inspectorData.(client.Person); ok {
+		last = len(m.personDetailLines(person)) - 1
+	}
+	m.timeline.propsScroll = styles.Max(0, styles.Min(m.timeline.propsScroll+delta, last))
+}
+
+// openTimelineEvent opens the event under the timeline cursor, or loads
+// more events on the "load more" row
+func (m *Model) openTimelineEvent() tea.Cmd {
+	t := m.timeline
+	if t.cursor >= len(t.events) {
+		return m.loadMoreTimeline()
+	}
+	event := t.events[t.cursor]
+	t.opened = &event
+	return nil
+}
+
+// renderPersonTimelineScrollable renders the pivoted person's details above
+// their recent events, or the event opened from the timeline
+func (m Model) renderPersonTimelineScrollable(width, height int) string {
+	t := m.timeline
+	if t.opened != nil {
+		event := m
+		event.inspectorData = *t.opened
+		return styles.DimTextStyle.Render("Esc to return to the timeline") + "\n" +
+			event.renderEventInspectorScrollable(width, height-1)
+	}
+
+	person, ok := m.inspectorData.(client.Person)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid person data")
+	}
+
+	// Split the viewport height between the person's details and events
+	available := styles.Max(6, height-8)
+	detailsHeight := available / 2
+	eventsHeight := available - detailsHeight - 2
+
+	details := m.personDetailLines(person)
+	scroll := styles.Max(0, styles.Min(t.propsScroll, len(details)-detailsHeight))
+	end := styles.Min(len(details), scroll+detailsHeight)
+	lines := append([]string{}, details[scroll:end]...)
+	for len(lines) < detailsHeight {
+		lines = append(lines, "")
+	}
+
+	header := fmt.Sprintf("Recent events (%d)", len(t.events))
+	if end < len(details) || scroll > 0 {
+		header += styles.DimTextStyle.Render(" · J/K scroll details")
+	}
+	lines = append(lines, styles.DimTextStyle.Render(strings.Repeat("─", styles.Max(1, width-8))))
+	lines = append(lines, styles.JSONKeyStyle.Render(header))
+
+	// Event rows plus a "load more" row, windowed around the cursor
+	var rows []string
+	for i, event := range t.events {
+		rows = append(rows, renderTimelineEvent(event, i == t.cursor, width-8))
+	}
+	selected := t.cursor == len(t.events)
+	switch {
+	case t.loading:
+		rows = append(rows, "  "+m.spinner.View()+" Loading events...")
+	case t.err != nil:
+		rows = append(rows, timelineRow(styles.ErrorTextStyle.Render(fmt.Sprintf("Couldn't load events: %v", t.err))+
+			styles.DimTextStyle.Render(" · Enter or n to retry"), selected))
+	case t.hasMore:
+		rows = append(rows, timelineRow(styles.DimTextStyle.Render("Load more (Enter or n)"), selected))
+	case len(t.events) == 0:
+		rows = append(rows, styles.DimTextStyle.Render("  No events for this person"))
+	}
+
+	first := styles.Max(0, styles.Min(t.cursor-eventsHeight/2, len(rows)-eventsHeight))
+	last := styles.Min(len(rows), first+eventsHeight)
+	lines = append(lines, rows[first:last]...)
+
+	return strings.Join(lines, "\n")
+}
+
+// renderTimelineEvent renders an event of the person timeline
+func renderTimelineEvent(event client.Event, selected bool, width int) string {
+	name := styles.TruncateString(event.Event, styles.Max(10, width/2))
+	line := fmt.Sprintf("%s %s",
+		styles.DimTextStyle.Render(client.FormatEventTimeShort(event.Timestamp.Local())),
+		name)
+	if url := utils.EventURL(event); url != "" {
+		if room := width - 20 - len(name); room >= 10 {
+			line += " " + styles.DimTextStyle.Render(styles.TruncateString(displayURL(url), room))
+		}
+	}
+	return timelineRow(line, selected)
+}
+
+// timelineRow prefixes a timeline row with the selection marker
+func timelineRow(line string, selected bool) string {
+	if selected {
+		return styles.HighlightTextStyle.Render("▶ ") + line
+	}
+	return "  " + line
+}
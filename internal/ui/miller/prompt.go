@@ -0,0 +1,71 @@
+package miller
+
+import (
+	"strings"
+
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// textPrompt is a single-line input shown above the list, e.g. to name a
+// new cohort. onSubmit is called with the trimmed value on Enter.
+type textPrompt struct {
+	input      textinput.Model
+	allowEmpty bool // submit an empty value, e.g. to clear a description
+	onSubmit   func(m *Model, value string) tea.Cmd
+}
+
+// openPrompt shows a text prompt with the given label
+func (m *Model) openPrompt(label, placeholder string, onSubmit func(m *Model, value string) tea.Cmd) {
+	input := textinput.New()
+	input.Placeholder = placeholder
+	input.Prompt = label + ": "
+	input.PromptStyle = styles.SearchPromptStyle
+	input.TextStyle = styles.SearchTextStyle
+	input.CharLimit = 200
+	input.Focus()
+
+	m.prompt = &textPrompt{input: input, onSubmit: onSubmit}
+}
+
+// setValue prefills the prompt, e.g. with the current value being edited,
+// and allows submitting an empty value to clear it
+func (p *textPrompt) setValue(value string) {
+	p.input.CharLimit = 0 // don't truncate long existing values
+	p.input.SetValue(value)
+	p.input.CursorEnd()
+	p.allowEmpty = true
+}
+
+// handlePromptKeys handles keyboard input while a text prompt is shown
+func (m Model) handlePromptKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc":
+		m.prompt = nil
+		return m, nil
+
+	case "enter":
+		value := strings.TrimSpace(m.prompt.input.Value())
+		if value == "" && !m.prompt.allowEmpty {
+			return m, nil
+		}
+		onSubmit := m.prompt.onSubmit
+		m.prompt = nil
+		return m, onSubmit(&m, value)
+	}
+
+	var cmd tea.Cmd
+	m.prompt.input, cmd = m.prompt.input.Update(msg)
+	return m, cmd
+}
+
+// renderPrompt renders the text prompt overlay
+func (m Model) renderPrompt(width int) string {
+	container := styles.SearchContainerStyle.Render(m.prompt.input.View())
+	return lipgloss.Place(width, 1, lipgloss.Center, lipgloss.Center, container)
+}
@@ -0,0 +1,203 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/components"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// maxBreakdownValues is the number of top property values shown
+	maxBreakdownValues = 20
+	// maxBreakdownLabelLen is the width of the value column
+	maxBreakdownLabelLen = 30
+)
+
+// breakdownView is the top values of an event property, shown as an overlay
+type breakdownView struct {
+	event      string
+	property   string
+	properties []string // property keys of the inspected event
+	window     int      // index into trendWindows
+	result     *client.PropertyBreakdown
+	loading    bool
+	err        error
+	seq        int // identifies the latest request, to drop stale responses
+}
+
+// breakdownMsg is sent when a property breakdown has been fetched
+type breakdownMsg struct {
+	seq       int
+	breakdown *client.PropertyBreakdown
+	err       error
+}
+
+// fetchPropertyBreakdown counts the top values of an event property
+func fetchPropertyBreakdown(c client.PostHogClient, seq int, event, property string, window time.Duration) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		breakdown, err := c.GetPropertyBreakdown(ctx, event, property, window, maxBreakdownValues)
+		return breakdownMsg{seq: seq, breakdown: breakdown, err: err}
+	}
+}
+
+// openPropertyBreakdown asks for a property key of the inspected event and
+// shows the breakdown of its values
+func (m *Model) openPropertyBreakdown() tea.Cmd {
+	event, ok := m.inspectorData.(client.Event)
+	if !ok || len(event.Properties) == 0 {
+		return nil
+	}
+
+	view := &breakdownView{
+		event:      event.Event,
+		properties: propertyKeys(event.Properties),
+		window:     1, // 7d
+	}
+	m.pickBreakdownProperty(view)
+	return nil
+}
+
+// pickBreakdownProperty opens a picker over the view's property keys and
+// shows the breakdown of the chosen one
+func (m *Model) pickBreakdownProperty(view *breakdownView) {
+	m.openPicker("Break down "+view.event+" by", view.properties, func(m *Model, key string) tea.Cmd {
+		view.property = key
+		view.result = nil
+		m.breakdown = view
+		return m.refreshBreakdown()
+	})
+}
+
+// refreshBreakdown refetches the property breakdown with its current settings
+func (m *Model) refreshBreakdown() tea.Cmd {
+	b := m.breakdown
+	b.seq++
+	b.loading = true
+	b.err = nil
+	return fetchPropertyBreakdown(m.client, b.seq, b.event, b.property, trendWindows[b.window].duration)
+}
+
+// applyBreakdown stores a fetched property breakdown, ignoring stale responses
+func (m *Model) applyBreakdown(msg breakdownMsg) {
+	if m.breakdown == nil || msg.seq != m.breakdown.seq {
+		return
+	}
+	m.breakdown.loading = false
+	m.breakdown.result = msg.breakdown
+	m.breakdown.err = msg.err
+}
+
+// handleBreakdownKeys handles keyboard input while a property breakdown is shown
+func (m Model) handleBreakdownKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	b := m.breakdown
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc", "q":
+		m.breakdown = nil
+		return m, nil
+
+	case "w":
+		b.window = (b.window + 1) % len(trendWindows)
+		return m, m.refreshBreakdown()
+
+	case "k":
+		m.pickBreakdownProperty(b)
+		return m, nil
+
+	case "r":
+		return m, m.refreshBreakdown()
+	}
+
+	return m, nil
+}
+
+// renderBreakdownOverlay renders the property breakdown overlay
+func (m Model) renderBreakdownOverlay(width, height int) string {
+	b := m.breakdown
+	var sb strings.Builder
+
+	sb.WriteString(styles.TitleStyle.Render(fmt.Sprintf("%s by %s", b.event, b.property)))
+	sb.WriteString("\n")
+	sb.WriteString(styles.DimTextStyle.Render("last " + trendWindows[b.window].label))
+	sb.WriteString("\n\n")
+
+	switch {
+	case b.loading && b.result == nil:
+		sb.WriteString(m.spinner.View() + " Loading values...")
+	case b.err != nil:
+		sb.WriteString(styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", b.err)))
+	default:
+		sb.WriteString(strings.Join(renderPropertyValues(*b.result, width-12), "\n"))
+		if b.loading {
+			sb.WriteString("\n" + m.spinner.View() + " Refreshing...")
+		}
+	}
+	sb.WriteString("\n\n")
+
+	hints := []string{
+		styles.KeyStyle.Render("w") + " window",
+		styles.KeyStyle.Render("k") + " property",
+		styles.KeyStyle.Render("r") + " refresh",
+		styles.KeyStyle.Render("Esc") + " close",
+	}
+	sb.WriteString(strings.Join(hints, " • "))
+
+	overlayStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorPrimary).
+		Padding(1, 2).
+		Width(width - 4).
+		Height(height - 4)
+
+	return overlayStyle.Render(sb.String())
+}
+
+// renderPropertyValues renders one bar per property value with its count
+// and share of all events
+func renderPropertyValues(breakdown client.PropertyBreakdown, width int) []string {
+	if len(breakdown.Values) == 0 || breakdown.Total == 0 {
+		return []string{styles.DimTextStyle.Render("No events in this window")}
+	}
+
+	barWidth := styles.Max(10, width-maxBreakdownLabelLen-20)
+	top := breakdown.Values[0].Count
+
+	var lines []string
+	var shown float64
+	for _, value := range breakdown.Values {
+		label := value.Value
+		if label == "" {
+			label = "(not set)"
+		}
+		shown += value.Count
+
+		lines = append(lines, fmt.Sprintf("%-*s %s %7s %5.1f%%",
+			maxBreakdownLabelLen, styles.TruncateString(label, maxBreakdownLabelLen),
+			components.RenderBar(value.Count/top, barWidth),
+			formatChartValue(value.Count), value.Count/breakdown.Total*100))
+	}
+
+	if rest := breakdown.Total - shown; rest > 0 {
+		lines = append(lines, styles.DimTextStyle.Render(fmt.Sprintf("%-*s %s %7s %5.1f%%",
+			maxBreakdownLabelLen, "(other values)", strings.Repeat(" ", barWidth),
+			formatChartValue(rest), rest/breakdown.Total*100)))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.JSONKeyStyle.Render("Total events: ")+formatChartValue(breakdown.Total))
+
+	return lines
+}
@@ -0,0 +1,174 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxPersonRecordings is the number of most recent recordings listed
+const maxPersonRecordings = 50
+
+// personRecordings holds a person's fetched session recordings
+type personRecordings struct {
+	recordings []client.SessionRecording
+	loading    bool
+	err        error
+}
+
+// personRecordingsMsg is sent when a person's recordings have been fetched
+type personRecordingsMsg struct {
+	personUUID string
+	recordings []client.SessionRecording
+	err        error
+}
+
+// fetchPersonRecordings fetches a person's most recent session recordings
+func fetchPersonRecordings(c client.PostHogClient, personUUID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		recordings, err := c.ListPersonRecordings(ctx, personUUID, maxPersonRecordings)
+		return personRecordingsMsg{personUUID: personUUID, recordings: recordings, err: err}
+	}
+}
+
+// inspectedRecordings returns the loaded recordings of the inspected person
+func (m Model) inspectedRecordings() []client.SessionRecording {
+	person, ok := m.inspectorData.(client.Person)
+	if !ok {
+		return nil
+	}
+	return m.personRecordings[personUUID(person)].recordings
+}
+
+// selectedRecording returns the recording under the cursor in the Recordings tab
+func (m Model) selectedRecording() (client.SessionRecording, bool) {
+	recordings := m.inspectedRecordings()
+	if m.activeInspectorTab() != TabRecordings || len(recordings) == 0 {
+		return client.SessionRecording{}, false
+	}
+	return recordings[styles.Min(m.recordingCursor, len(recordings)-1)], true
+}
+
+// moveRecordingCursor moves the cursor in the Recordings tab by delta
+func (m *Model) moveRecordingCursor(delta int) {
+	recordings := m.inspectedRecordings()
+	if len(recordings) == 0 {
+		return
+	}
+	cursor := styles.Min(m.recordingCursor, len(recordings)-1) + delta
+	m.recordingCursor = styles.Max(0, styles.Min(cursor, len(recordings)-1))
+}
+
+// copyReplayURL copies the selected recording's replay URL to the clipboard
+func (m *Model) copyReplayURL() {
+	recording, ok := m.selectedRecording()
+	if !ok {
+		return
+	}
+	if err := m.CopyToClipboard(m.client.ReplayURL(recording.ID)); err != nil {
+		m.showClipboardFeedback("Copy failed")
+		return
+	}
+	m.showClipboardFeedback("Copied replay URL")
+}
+
+// openReplay opens the selected recording's replay in the browser
+func (m *Model) openReplay() {
+	recording, ok := m.selectedRecording()
+	if !ok {
+		return
+	}
+	if err := utils.OpenURL(m.client.ReplayURL(recording.ID)); err != nil {
+		m.showClipboardFeedback("Open failed")
+		return
+	}
+	m.showClipboardFeedback("Opened replay")
+}
+
+// renderPersonRecordingsScrollable renders a person's session recordings,
+// newest first, with the selected one highlighted
+func (m Model) renderPersonRecordingsScrollable(width, height int) string {
+	person, ok := m.inspectorData.(client.Person)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid person data")
+	}
+
+	var lines []string
+	cursorLine := 0
+
+	loaded, ok := m.personRecordings[personUUID(person)]
+	switch {
+	case !ok || loaded.loading:
+		lines = append(lines, m.spinner.View()+" Loading recordings...")
+	case loaded.err != nil:
+		lines = append(lines, styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", loaded.err)))
+	case len(loaded.recordings) == 0:
+		lines = append(lines, styles.DimTextStyle.Render(fmt.Sprintf("No recordings for this person in the last %d days", client.RecordingsLookbackDays)))
+	default:
+		lines = append(lines, styles.DimTextStyle.Render(fmt.Sprintf("%d recordings in the last %d days · o open replay · u copy URL",
+			len(loaded.recordings), client.RecordingsLookbackDays)))
+		lines = append(lines, "")
+
+		cursor := styles.Min(m.recordingCursor, len(loaded.recordings)-1)
+		for i, recording := range loaded.recordings {
+			if i == cursor {
+				cursorLine = len(lines)
+			}
+			lines = append(lines, renderRecordingLines(recording, i == cursor, width-8)...)
+		}
+	}
+
+	// Build full content and update viewport, keeping the cursor in view
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+	m.inspectorViewport.SetYOffset(cursorLine - m.inspectorViewport.Height/2)
+
+	return m.inspectorViewport.View()
+}
+
+// renderRecordingLines renders a recording as a summary line and its start URL
+func renderRecordingLines(recording client.SessionRecording, selected bool, width int) []string {
+	start := client.FormatEventTime(recording.StartTime.Local())
+	if recording.Ongoing {
+		start += " " + styles.SuccessTextStyle.Render("live")
+	}
+
+	stats := []string{
+		utils.FormatDuration(recording.Duration()),
+		"active " + utils.FormatDuration(recording.ActiveDuration()),
+		fmt.Sprintf("%d clicks", recording.ClickCount),
+	}
+	errors := fmt.Sprintf("%d errors", recording.ConsoleErrorCount)
+	if recording.ConsoleErrorCount > 0 {
+		errors = styles.ErrorTextStyle.Render(errors)
+	}
+
+	summary := start + "  " + styles.DimTextStyle.Render(strings.Join(stats, " · ")+" · ") + errors
+	if selected {
+		summary = styles.HighlightTextStyle.Render("▶ ") + summary
+	} else {
+		marker := "  "
+		if !recording.Viewed {
+			marker = styles.WarningTextStyle.Render("•") + " "
+		}
+		summary = marker + summary
+	}
+
+	url := recording.StartURL
+	if url == "" {
+		url = "(no start URL)"
+	}
+
+	return []string{summary, "    " + styles.DimTextStyle.Render(styles.TruncateString(url, width-4))}
+}
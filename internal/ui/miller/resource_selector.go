@@ -14,8 +14,36 @@ const (
 	ResourceEvents Resource = iota
 	ResourcePersons
 	ResourceFlags
+	ResourceFlagAudit
+	ResourceExperiments
+	ResourceCohorts
+	ResourceDashboards
+	ResourceSchema
+	ResourceActions
+	ResourceAnnotations
+	ResourceSurveys
+	ResourceExceptions
+	ResourceGroups
 )
 
+// allResources lists the resources in the order they appear in Pane 1.
+// The order must match the constants above, as pane1Cursor indexes it.
+var allResources = []Resource{
+	ResourceEvents,
+	ResourcePersons,
+	ResourceFlags,
+	ResourceFlagAudit,
+	ResourceExperiments,
+	ResourceCohorts,
+	ResourceDashboards,
+	ResourceSchema,
+	ResourceActions,
+	ResourceAnnotations,
+	ResourceSurveys,
+	ResourceExceptions,
+	ResourceGroups,
+}
+
 // String returns a human-readable representation of the resource
 func (r Resource) String() string {
 	switch r {
@@ -25,6 +53,26 @@ func (r Resource) String() string {
 		return "Persons"
 	case ResourceFlags:
 		return "Flags"
+	case ResourceFlagAudit:
+		return "Flag Audit"
+	case ResourceExperiments:
+		return "Experiments"
+	case ResourceCohorts:
+		return "Cohorts"
+	case ResourceDashboards:
+		return "Dashboards"
+	case ResourceSchema:
+		return "Schema"
+	case ResourceActions:
+		return "Actions"
+	case ResourceAnnotations:
+		return "Annotations"
+	case ResourceSurveys:
+		return "Surveys"
+	case ResourceExceptions:
+		return "Exceptions"
+	case ResourceGroups:
+		return "Groups"
 	default:
 		return "Unknown"
 	}
@@ -39,6 +87,26 @@ func (r Resource) Icon() string {
 		return "👤"
 	case ResourceFlags:
 		return "🚩"
+	case ResourceFlagAudit:
+		return "🧹"
+	case ResourceExperiments:
+		return "🧪"
+	case ResourceCohorts:
+		return "👥"
+	case ResourceDashboards:
+		return "📊"
+	case ResourceSchema:
+		return "📖"
+	case ResourceActions:
+		return "🎯"
+	case ResourceAnnotations:
+		return "📌"
+	case ResourceSurveys:
+		return "📋"
+	case ResourceExceptions:
+		return "💥"
+	case ResourceGroups:
+		return "🏢"
 	default:
 		return "❓"
 	}
@@ -98,7 +166,7 @@ func (m Model) renderResourceSelector(width, height int) string {
 	sb.WriteString("\n\n") // Extra spacing between sections
 
 	// Resource rendering
-	resources := []Resource{ResourceEvents, ResourcePersons, ResourceFlags}
+	resources := allResources
 
 	for i, resource := range resources {
 		// Check if THIS resource is selected based on cursor position
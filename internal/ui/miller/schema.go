@@ -0,0 +1,302 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/components"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxDefinitionNameLen is the truncation length of event names in the list
+const maxDefinitionNameLen = 35
+
+// eventProperties holds the fetched property definitions of an event
+type eventProperties struct {
+	properties []client.PropertyDefinition
+	loading    bool
+	err        error
+}
+
+// schemaMsg is sent when the event definitions have been fetched
+type schemaMsg []client.EventDefinition
+
+// eventPropertiesMsg is sent when an event's property definitions have been fetched
+type eventPropertiesMsg struct {
+	event      string
+	properties []client.PropertyDefinition
+	err        error
+}
+
+func fetchSchema(c client.PostHogClient) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		definitions, err := c.ListEventDefinitions(ctx)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		return schemaMsg(definitions)
+	}
+}
+
+// fetchEventProperties fetches the definitions of the properties seen on an event
+func fetchEventProperties(c client.PostHogClient, event string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		properties, err := c.ListEventProperties(ctx, event)
+		return eventPropertiesMsg{event: event, properties: properties, err: err}
+	}
+}
+
+// setSchemaListItems lists event definitions by 30 day volume, busiest first
+func (m *Model) setSchemaListItems(definitions []client.EventDefinition) {
+	sort.SliceStable(definitions, func(i, j int) bool {
+		vi, vj := definitionVolume(definitions[i]), definitionVolume(definitions[j])
+		if vi != vj {
+			return vi > vj
+		}
+		return definitions[i].Name < definitions[j].Name
+	})
+
+	m.listItems = make([]ListItem, len(definitions))
+	names := make([]string, len(definitions))
+	for i, definition := range definitions {
+		m.listItems[i] = EventDefinitionListItem{Definition: definition, Marked: m.markedDefinitions[definition.ID]}
+		names[i] = definition.Name
+	}
+
+	// Offer the same vocabulary in event pickers
+	sort.Strings(names)
+	m.eventNames = names
+}
+
+// definitionVolume returns an event's 30 day volume, or -1 if unknown
+func definitionVolume(d client.EventDefinition) int {
+	if d.Volume30Day == nil {
+		return -1
+	}
+	return *d.Volume30Day
+}
+
+// tailSelectedDefinition switches to the live Events list, filtered to the
+// inspected event definition
+func (m *Model) tailSelectedDefinition() tea.Cmd {
+	definition, ok := m.inspectorData.(client.EventDefinition)
+	if !ok {
+		return nil
+	}
+	return m.tailEvents(definition.Name, "event = "+client.QuoteHogQLString(definition.Name))
+}
+
+// tailEvents switches to the live Events list, filtered by a HogQL condition
+// and labelled in the list title
+func (m *Model) tailEvents(label, condition string) tea.Cmd {
+	m.pushHistory()
+	m.eventFilter = label
+	m.eventCondition = condition
+	m.autoScroll = true
+	m.newEventCount = 0
+	m.filteredItems = nil
+	m.focus = FocusPane2
+	return m.showResource(ResourceEvents)
+}
+
+// EventDefinitionListItem wraps a client.EventDefinition for list display
+type EventDefinitionListItem struct {
+	Definition client.EventDefinition
+	Marked     bool // selected for a bulk hide
+}
+
+func (d EventDefinitionListItem) RenderLine(width int, selected bool) string {
+	name := d.Definition.Name
+	if len(name) > maxDefinitionNameLen {
+		name = styles.TruncateString(name, maxDefinitionNameLen)
+	}
+
+	status := " "
+	switch {
+	case d.Definition.Hidden:
+		status = styles.DimTextStyle.Render("⊘")
+	case d.Definition.Verified:
+		status = styles.SuccessTextStyle.Render("✓")
+	}
+
+	volume := "–"
+	if d.Definition.Volume30Day != nil {
+		volume = components.FormatCompactNumber(float64(*d.Definition.Volume30Day))
+	}
+
+	line := fmt.Sprintf("%s %s %s", status, name, styles.DimTextStyle.Render(volume))
+
+	// Bulk selection indicator
+	if d.Marked {
+		line = styles.WarningTextStyle.Render("✓") + line
+	} else {
+		line = " " + line
+	}
+
+	if selected {
+		line = styles.SelectedListItemStyle.Render("▶ " + line)
+	} else {
+		line = styles.ListItemStyle.Render("  " + line)
+	}
+
+	return line
+}
+
+func (d EventDefinitionListItem) GetID() string {
+	return d.Definition.ID
+}
+
+func (d EventDefinitionListItem) GetInspectorData() interface{} {
+	return d.Definition
+}
+
+func (d EventDefinitionListItem) GetDistinctID() string {
+	return "" // Definitions don't have distinct IDs
+}
+
+func (d EventDefinitionListItem) GetSearchableText() string {
+	return d.Definition.Name + " " + strings.Join(d.Definition.Tags, " ") + " " + d.Definition.Description
+}
+
+// renderSchemaInspectorScrollable renders an event definition's metadata
+func (m Model) renderSchemaInspectorScrollable(width, height int) string {
+	definition, ok := m.inspectorData.(client.EventDefinition)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid event definition data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Event: ")+definition.Name)
+
+	status := "unverified"
+	if definition.Verified {
+		status = styles.SuccessTextStyle.Render("verified")
+	}
+	if definition.Hidden {
+		status += styles.DimTextStyle.Render(" · hidden")
+	}
+	lines = append(lines, styles.JSONKeyStyle.Render("Status: ")+status)
+
+	volume := styles.DimTextStyle.Render("unknown")
+	if definition.Volume30Day != nil {
+		volume = fmt.Sprintf("%d events", *definition.Volume30Day)
+	}
+	lines = append(lines, styles.JSONKeyStyle.Render("Volume (30d): ")+volume)
+
+	if definition.QueryUsage30Day != nil {
+		lines = append(lines, styles.JSONKeyStyle.Render("Queries (30d): ")+fmt.Sprintf("%d", *definition.QueryUsage30Day))
+	}
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Last seen: ")+formatDefinitionTime(definition.LastSeenAt))
+	lines = append(lines, styles.JSONKeyStyle.Render("Created: ")+formatDefinitionTime(definition.CreatedAt))
+
+	if definition.Owner != nil {
+		lines = append(lines, styles.JSONKeyStyle.Render("Owner: ")+ownerLabel(*definition.Owner))
+	}
+	if len(definition.Tags) > 0 {
+		lines = append(lines, styles.JSONKeyStyle.Render("Tags: ")+strings.Join(definition.Tags, ", "))
+	}
+
+	lines = append(lines, "")
+	if definition.Description != "" {
+		lines = append(lines, definition.Description)
+	} else {
+		lines = append(lines, styles.DimTextStyle.Render("No description"))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.DimTextStyle.Render("Press L to tail live "+definition.Name+" events"))
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// renderEventPropertiesScrollable renders the properties seen on an event
+// with their types, verification status, tags and descriptions
+func (m Model) renderEventPropertiesScrollable(width, height int) string {
+	definition, ok := m.inspectorData.(client.EventDefinition)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid event definition data")
+	}
+
+	var lines []string
+
+	props, loaded := m.eventProperties[definition.Name]
+	switch {
+	case !loaded || props.loading:
+		lines = append(lines, m.spinner.View()+" Loading properties...")
+	case props.err != nil:
+		lines = append(lines, styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", props.err)))
+	case len(props.properties) == 0:
+		lines = append(lines, styles.DimTextStyle.Render("No properties seen on this event"))
+	default:
+		lines = append(lines, styles.DimTextStyle.Render(fmt.Sprintf("%d properties", len(props.properties))))
+		lines = append(lines, "")
+		for _, property := range props.properties {
+			status := " "
+			switch {
+			case property.Hidden:
+				status = styles.DimTextStyle.Render("⊘")
+			case property.Verified:
+				status = styles.SuccessTextStyle.Render("✓")
+			}
+
+			line := fmt.Sprintf("%s %s %s", status, styles.JSONKeyStyle.Render(property.Name),
+				styles.DimTextStyle.Render(property.Type()))
+			if len(property.Tags) > 0 {
+				line += " " + styles.HighlightTextStyle.Render(strings.Join(property.Tags, ","))
+			}
+			lines = append(lines, line)
+
+			if property.Description != "" {
+				lines = append(lines, "    "+styles.TruncateString(property.Description, width-12))
+			}
+		}
+	}
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// formatDefinitionTime formats a definition timestamp with how long ago it was
+func formatDefinitionTime(t *time.Time) string {
+	if t == nil {
+		return styles.DimTextStyle.Render("never")
+	}
+	return client.FormatEventTime(t.Local()) +
+		styles.DimTextStyle.Render(" ("+utils.FormatDuration(time.Since(*t))+" ago)")
+}
+
+// ownerLabel returns the owner's name, falling back to their email
+func ownerLabel(owner client.DefinitionOwner) string {
+	if owner.FirstName == "" {
+		return owner.Email
+	}
+	if owner.Email == "" {
+		return owner.FirstName
+	}
+	return fmt.Sprintf("%s <%s>", owner.FirstName, owner.Email)
+}
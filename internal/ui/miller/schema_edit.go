@@ -0,0 +1,405 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// definitionTarget is the definition an edit applies to: the inspected event
+// definition, or one of its properties
+type definitionTarget struct {
+	event    client.EventDefinition
+	property *client.PropertyDefinition
+}
+
+func (t definitionTarget) name() string {
+	if t.property != nil {
+		return t.property.Name
+	}
+	return t.event.Name
+}
+
+func (t definitionTarget) description() string {
+	if t.property != nil {
+		return t.property.Description
+	}
+	return t.event.Description
+}
+
+func (t definitionTarget) tags() []string {
+	if t.property != nil {
+		return t.property.Tags
+	}
+	return t.event.Tags
+}
+
+func (t definitionTarget) verified() bool {
+	if t.property != nil {
+		return t.property.Verified
+	}
+	return t.event.Verified
+}
+
+func (t definitionTarget) hidden() bool {
+	if t.property != nil {
+		return t.property.Hidden
+	}
+	return t.event.Hidden
+}
+
+// update returns a command applying the update to the target definition
+func (t definitionTarget) update(c client.PostHogClient, update client.DefinitionUpdate, action string) tea.Cmd {
+	if t.property != nil {
+		return updatePropertyDefinition(c, t.event.Name, t.property.ID, update, action)
+	}
+	return updateEventDefinition(c, t.event.ID, update, action)
+}
+
+// definitionUpdatedMsg is sent when an event definition has been updated
+type definitionUpdatedMsg struct {
+	definition *client.EventDefinition
+	action     string
+	err        error
+}
+
+// propertyUpdatedMsg is sent when a property definition has been updated
+type propertyUpdatedMsg struct {
+	event    string
+	property *client.PropertyDefinition
+	action   string
+	err      error
+}
+
+// definitionsHiddenMsg is sent when a bulk hide has finished
+type definitionsHiddenMsg []utils.DefinitionUpdateResult
+
+// membersMsg is sent when the organization members have been fetched
+type membersMsg struct {
+	members []client.DefinitionOwner
+	err     error
+}
+
+func updateEventDefinition(c client.PostHogClient, id string, update client.DefinitionUpdate, action string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		definition, err := c.UpdateEventDefinition(ctx, id, update)
+		return definitionUpdatedMsg{definition: definition, action: action, err: err}
+	}
+}
+
+func updatePropertyDefinition(c client.PostHogClient, event, id string, update client.DefinitionUpdate, action string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		property, err := c.UpdatePropertyDefinition(ctx, id, update)
+		return propertyUpdatedMsg{event: event, property: property, action: action, err: err}
+	}
+}
+
+// hideEventDefinitions hides the given event definitions concurrently
+func hideEventDefinitions(c client.PostHogClient, definitions []client.EventDefinition) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		hidden := true
+		update := client.DefinitionUpdate{Hidden: &hidden}
+		return definitionsHiddenMsg(utils.UpdateEventDefinitions(ctx, c, definitions, update, utils.DefaultBulkWorkers))
+	}
+}
+
+func fetchMembers(c client.PostHogClient) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		members, err := c.ListOrganizationMembers(ctx)
+		return membersMsg{members: members, err: err}
+	}
+}
+
+// withDefinitionTarget calls edit with the definition to edit: a property
+// picked from the Properties tab when it is shown, otherwise the inspected
+// event definition
+func (m *Model) withDefinitionTarget(title string, edit func(m *Model, t definitionTarget) tea.Cmd) tea.Cmd {
+	event, ok := m.inspectorData.(client.EventDefinition)
+	if !ok {
+		return nil
+	}
+
+	if m.focus != FocusPane3 || m.activeInspectorTab() != TabProperties {
+		return edit(m, definitionTarget{event: event})
+	}
+
+	props := m.eventProperties[event.Name]
+	if len(props.properties) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(props.properties))
+	for i, property := range props.properties {
+		names[i] = property.Name
+	}
+	m.openPicker(title, names, func(m *Model, name string) tea.Cmd {
+		for _, property := range props.properties {
+			if property.Name == name {
+				property := property
+				return edit(m, definitionTarget{event: event, property: &property})
+			}
+		}
+		return nil
+	})
+	return nil
+}
+
+// editDefinition starts the metadata edit bound to key
+func (m *Model) editDefinition(key string) tea.Cmd {
+	switch key {
+	case "e":
+		return m.editDefinitionDescription()
+	case "T":
+		return m.editDefinitionTags()
+	case "o":
+		return m.editDefinitionOwner()
+	case "V":
+		return m.toggleDefinitionVerified()
+	case "H":
+		return m.toggleDefinitionHidden()
+	}
+	return nil
+}
+
+// editDefinitionDescription prompts for a new description
+func (m *Model) editDefinitionDescription() tea.Cmd {
+	return m.withDefinitionTarget("Edit description of", func(m *Model, t definitionTarget) tea.Cmd {
+		m.openPrompt("Description", "Describe "+t.name(), func(m *Model, value string) tea.Cmd {
+			return t.update(m.client, client.DefinitionUpdate{Description: &value}, "Updated description")
+		})
+		m.prompt.setValue(t.description())
+		return nil
+	})
+}
+
+// editDefinitionTags prompts for a new comma-separated list of tags
+func (m *Model) editDefinitionTags() tea.Cmd {
+	return m.withDefinitionTarget("Edit tags of", func(m *Model, t definitionTarget) tea.Cmd {
+		m.openPrompt("Tags", "comma,separated", func(m *Model, value string) tea.Cmd {
+			tags := utils.ParseTags(value)
+			return t.update(m.client, client.DefinitionUpdate{Tags: &tags}, "Updated tags")
+		})
+		m.prompt.setValue(strings.Join(t.tags(), ", "))
+		return nil
+	})
+}
+
+// toggleDefinitionVerified marks a definition verified or unverified
+func (m *Model) toggleDefinitionVerified() tea.Cmd {
+	return m.withDefinitionTarget("Toggle verified", func(m *Model, t definitionTarget) tea.Cmd {
+		verified := !t.verified()
+		action := "Verified " + t.name()
+		if !verified {
+			action = "Unverified " + t.name()
+		}
+		return t.update(m.client, client.DefinitionUpdate{Verified: &verified}, action)
+	})
+}
+
+// toggleDefinitionHidden hides the marked event definitions, or toggles
+// whether the selected definition is hidden if none are marked
+func (m *Model) toggleDefinitionHidden() tea.Cmd {
+	if marked := m.markedDefinitionList(); len(marked) > 0 && m.focus == FocusPane2 {
+		m.showClipboardFeedback(fmt.Sprintf("Hiding %d definitions...", len(marked)))
+		return hideEventDefinitions(m.client, marked)
+	}
+
+	return m.withDefinitionTarget("Toggle hidden", func(m *Model, t definitionTarget) tea.Cmd {
+		hidden := !t.hidden()
+		action := "Hid " + t.name()
+		if !hidden {
+			action = "Unhid " + t.name()
+		}
+		return t.update(m.client, client.DefinitionUpdate{Hidden: &hidden}, action)
+	})
+}
+
+// editDefinitionOwner picks a new owner for the inspected event definition
+// from the organization members, fetching them first if needed
+func (m *Model) editDefinitionOwner() tea.Cmd {
+	event, ok := m.inspectorData.(client.EventDefinition)
+	if !ok {
+		return nil
+	}
+
+	if m.members == nil {
+		m.ownerPickerWait = true
+		return fetchMembers(m.client)
+	}
+
+	labels := make([]string, len(m.members))
+	ids := make(map[string]int, len(m.members))
+	for i, member := range m.members {
+		labels[i] = ownerLabel(member)
+		ids[labels[i]] = member.ID
+	}
+
+	m.openPicker("Owner of "+event.Name, labels, func(m *Model, label string) tea.Cmd {
+		owner := ids[label]
+		return updateEventDefinition(m.client, event.ID, client.DefinitionUpdate{Owner: &owner}, "Owner set to "+label)
+	})
+	return nil
+}
+
+// applyMembers stores fetched members and opens a pending owner picker
+func (m *Model) applyMembers(msg membersMsg) tea.Cmd {
+	wait := m.ownerPickerWait
+	m.ownerPickerWait = false
+
+	if msg.err != nil {
+		m.showClipboardFeedback(fmt.Sprintf("Failed to load members: %v", msg.err))
+		return nil
+	}
+	m.members = msg.members
+
+	if !wait || m.selectedResource != ResourceSchema {
+		return nil
+	}
+	return m.editDefinitionOwner()
+}
+
+// applyDefinitionUpdate replaces an updated event definition in the list
+func (m *Model) applyDefinitionUpdate(msg definitionUpdatedMsg) {
+	if msg.err != nil {
+		m.showClipboardFeedback(fmt.Sprintf("Update failed: %v", msg.err))
+		return
+	}
+	m.replaceEventDefinition(*msg.definition)
+	m.showClipboardFeedback(msg.action)
+}
+
+// applyPropertyUpdate replaces an updated property definition of an event
+func (m *Model) applyPropertyUpdate(msg propertyUpdatedMsg) {
+	if msg.err != nil {
+		m.showClipboardFeedback(fmt.Sprintf("Update failed: %v", msg.err))
+		return
+	}
+
+	props := m.eventProperties[msg.event]
+	for i, property := range props.properties {
+		if property.ID == msg.property.ID {
+			props.properties[i] = *msg.property
+		}
+	}
+	m.showClipboardFeedback(msg.action)
+}
+
+// applyDefinitionsHidden records the outcome of a bulk hide
+func (m *Model) applyDefinitionsHidden(results []utils.DefinitionUpdateResult) {
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			continue
+		}
+		delete(m.markedDefinitions, r.Definition.ID)
+		m.replaceEventDefinition(r.Definition)
+	}
+	m.refreshDefinitionMarks()
+
+	feedback := fmt.Sprintf("Hid %d definitions", len(results)-failed)
+	if failed > 0 {
+		feedback += fmt.Sprintf(" (%d failed, still marked)", failed)
+	}
+	m.showClipboardFeedback(feedback)
+}
+
+// replaceEventDefinition updates the list items and inspector showing a definition
+func (m *Model) replaceEventDefinition(definition client.EventDefinition) {
+	for _, items := range [][]ListItem{m.listItems, m.filteredItems} {
+		for i, item := range items {
+			if d, ok := item.(EventDefinitionListItem); ok && d.Definition.ID == definition.ID {
+				d.Definition = definition
+				items[i] = d
+			}
+		}
+	}
+
+	if current, ok := m.inspectorData.(client.EventDefinition); ok && current.ID == definition.ID {
+		m.inspectorData = definition
+	}
+}
+
+// toggleDefinitionMark marks or unmarks the definition under the cursor
+func (m *Model) toggleDefinitionMark() {
+	items := m.getEffectiveListItems()
+	if m.listCursor >= len(items) {
+		return
+	}
+	d, ok := items[m.listCursor].(EventDefinitionListItem)
+	if !ok {
+		return
+	}
+
+	if m.markedDefinitions[d.Definition.ID] {
+		delete(m.markedDefinitions, d.Definition.ID)
+	} else {
+		m.markedDefinitions[d.Definition.ID] = true
+	}
+	m.refreshDefinitionMarks()
+}
+
+// toggleAllDefinitionMarks marks all visible definitions, or unmarks them
+// if they are all marked already
+func (m *Model) toggleAllDefinitionMarks() {
+	items := m.getEffectiveListItems()
+
+	allMarked := len(items) > 0
+	for _, item := range items {
+		if d, ok := item.(EventDefinitionListItem); ok && !m.markedDefinitions[d.Definition.ID] {
+			allMarked = false
+			break
+		}
+	}
+
+	for _, item := range items {
+		if d, ok := item.(EventDefinitionListItem); ok {
+			if allMarked {
+				delete(m.markedDefinitions, d.Definition.ID)
+			} else {
+				m.markedDefinitions[d.Definition.ID] = true
+			}
+		}
+	}
+	m.refreshDefinitionMarks()
+}
+
+// refreshDefinitionMarks syncs the Marked state of list items with markedDefinitions
+func (m *Model) refreshDefinitionMarks() {
+	for _, items := range [][]ListItem{m.listItems, m.filteredItems} {
+		for i, item := range items {
+			if d, ok := item.(EventDefinitionListItem); ok {
+				d.Marked = m.markedDefinitions[d.Definition.ID]
+				items[i] = d
+			}
+		}
+	}
+}
+
+// markedDefinitionList returns the marked event definitions in list order
+func (m Model) markedDefinitionList() []client.EventDefinition {
+	var definitions []client.EventDefinition
+	for _, item := range m.listItems {
+		if d, ok := item.(EventDefinitionListItem); ok && m.markedDefinitions[d.Definition.ID] {
+			definitions = append(definitions, d.Definition)
+		}
+	}
+	return definitions
+}
@@ -17,6 +17,11 @@ func (m *Model) enterSearchMode() {
 	m.searchInput.Prompt = "🔍 "
 	m.searchInput.PromptStyle = styles.SearchPromptStyle
 	m.searchInput.TextStyle = styles.SearchTextStyle
+	if m.selectedResource == ResourcePersons {
+		// Persons are searched server-side, by email, name, distinct ID or property
+		m.searchInput.Placeholder = "Search email, name, distinct ID or prop=value..."
+		m.searchInput.SetValue(m.personSearch)
+	}
 	m.searchInput.Focus()
 }
 
@@ -30,9 +35,16 @@ func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.searchMode = false
 		m.searchInput.Blur()
 		m.filteredItems = nil
+		if m.selectedResource == ResourcePersons {
+			return m, m.searchPersons("")
+		}
 		return m, nil
 
 	case "enter":
+		if m.selectedResource == ResourcePersons {
+			return m, m.searchPersons(m.searchInput.Value())
+		}
+
 		// Apply filter
 		query := m.searchInput.Value()
 		if query != "" {
@@ -53,6 +65,24 @@ func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// searchPersons lists the persons matching a search, fetched from the
+// server rather than filtered from the persons already listed
+func (m *Model) searchPersons(query string) tea.Cmd {
+	m.searchMode = false
+	m.searchInput.Blur()
+	query = strings.Join(strings.Fields(query), " ")
+	if query == m.personSearch {
+		return nil
+	}
+
+	m.pushHistory()
+	m.personSearch = query
+	m.loading = true
+	m.listCursor = 0
+	m.inspectorData = nil
+	return m.fetchCurrentResource()
+}
+
 // applyFilter filters list items by substring match
 // Searches in the searchable text (case-insensitive, no ANSI codes)
 func (m Model) applyFilter(items []ListItem, query string) []ListItem {
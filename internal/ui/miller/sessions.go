@@ -0,0 +1,358 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// maxSessionEvents is the number of a person's most recent events grouped
+	// into sessions
+	maxSessionEvents = 500
+	// maxSessionListLines is the height of the session list above the timeline
+	maxSessionListLines = 8
+)
+
+// sessionsView groups a person's or the live stream's events by
+// $session_id, shown as an overlay
+type sessionsView struct {
+	title      string
+	personUUID string // whose events are fetched, empty for the live stream
+	sessions   []utils.Session
+	cursor     int
+	scroll     int // first timeline line shown
+	loading    bool
+	err        error
+	seq        int // identifies the latest request, to drop stale responses
+}
+
+// sessionEventsMsg is sent when a person's events have been fetched for
+// grouping into sessions
+type sessionEventsMsg struct {
+	seq    int
+	events []client.Event
+	err    error
+}
+
+// fetchSessionEvents fetches a person's (by UUID) most recent events
+func fetchSessionEvents(c client.PostHogClient, seq int, personUUID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		events, err := c.ListRecentEventsMatching(ctx, "person_id = "+client.QuoteHogQLString(personUUID), maxSessionEvents)
+		return sessionEventsMsg{seq: seq, events: events, err: err}
+	}
+}
+
+// openSessions opens Sessions mode for the selected person, or for the
+// events loaded in the live stream with the selected event's session first
+// under the cursor
+func (m *Model) openSessions() tea.Cmd {
+	switch m.selectedResource {
+	case ResourceEvents:
+		view := &sessionsView{title: "live stream"}
+		view.sessions = utils.GroupSessions(m.listedEvents())
+		if event, ok := m.inspectorData.(client.Event); ok {
+			view.cursor = sessionIndex(view.sessions, utils.EventSessionID(event))
+		}
+		m.sessions = view
+		return nil
+
+	case ResourcePersons:
+		person, ok := m.inspectorData.(client.Person)
+		if !ok || personUUID(person) == "" {
+			return nil
+		}
+		title := person.Name
+		if title == "" && len(person.DistinctIDs) > 0 {
+			title = person.DistinctIDs[0]
+		}
+		m.sessions = &sessionsView{title: title, personUUID: personUUID(person)}
+		return m.refreshSessions()
+	}
+	return nil
+}
+
+// refreshSessions refetches the person's events, or regroups the events
+// loaded in the live stream
+func (m *Model) refreshSessions() tea.Cmd {
+	s := m.sessions
+	if s.personUUID == "" {
+		s.sessions = utils.GroupSessions(m.listedEvents())
+		s.cursor = styles.Max(0, styles.Min(s.cursor, len(s.sessions)-1))
+		return nil
+	}
+
+	s.seq++
+	s.loading = true
+	s.err = nil
+	return fetchSessionEvents(m.client, s.seq, s.personUUID)
+}
+
+// applySessionEvents groups fetched events into sessions, ignoring stale responses
+func (m *Model) applySessionEvents(msg sessionEventsMsg) {
+	if m.sessions == nil || msg.seq != m.sessions.seq {
+		return
+	}
+	s := m.sessions
+	s.loading = false
+	s.err = msg.err
+	if msg.err == nil {
+		s.sessions = utils.GroupSessions(msg.events)
+		s.cursor = 0
+		s.scroll = 0
+	}
+}
+
+// listedEvents returns the events in the Events list
+func (m Model) listedEvents() []client.Event {
+	var events []client.Event
+	for _, item := range m.listItems {
+		if eventItem, ok := item.(EventListItem); ok {
+			events = append(events, eventItem.Event)
+		}
+	}
+	return events
+}
+
+// sessionIndex returns the index of the session with the given ID, or 0
+func sessionIndex(sessions []utils.Session, id string) int {
+	for i, session := range sessions {
+		if session.ID == id {
+			return i
+		}
+	}
+	return 0
+}
+
+// handleSessionsKeys handles keyboard input while Sessions mode is shown
+func (m Model) handleSessionsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	s := m.sessions
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc", "q":
+		m.sessions = nil
+		return m, nil
+
+	case "j", "down":
+		if s.cursor < len(s.sessions)-1 {
+			s.cursor++
+			s.scroll = 0
+		}
+		return m, nil
+
+	case "k", "up":
+		if s.cursor > 0 {
+			s.cursor--
+			s.scroll = 0
+		}
+		return m, nil
+
+	case "ctrl+d", "J":
+		if len(s.sessions) > 0 {
+			s.scroll = styles.Min(s.scroll+10, len(s.sessions[s.cursor].Events)-1)
+		}
+		return m, nil
+
+	case "ctrl+u", "K":
+		s.scroll = styles.Max(0, s.scroll-10)
+		return m, nil
+
+	case "c":
+		// Copy the selected session's ID
+		if len(s.sessions) > 0 && s.sessions[s.cursor].ID != "" {
+			if err := m.CopyToClipboard(s.sessions[s.cursor].ID); err != nil {
+				m.showClipboardFeedback("Copy failed")
+			} else {
+				m.showClipboardFeedback("Copied session ID")
+			}
+		}
+		return m, nil
+
+	case "r":
+		return m, m.refreshSessions()
+	}
+
+	return m, nil
+}
+
+// renderSessionsOverlay renders the session list above the selected
+// session's summary and timeline
+func (m Model) renderSessionsOverlay(width, height int) string {
+	s := m.sessions
+	var lines []string
+
+	title := "Sessions · " + s.title
+	if !m.clipboardTime.IsZero() && time.Since(m.clipboardTime) < 2*time.Second {
+		title += " - " + m.clipboardMsg
+	}
+	lines = append(lines, styles.TitleStyle.Render(title))
+
+	contentWidth := width - 10
+	switch {
+	case s.loading:
+		lines = append(lines, "", m.spinner.View()+" Loading events...")
+	case s.err != nil:
+		lines = append(lines, "", styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", s.err)))
+	case len(s.sessions) == 0:
+		lines = append(lines, "", styles.DimTextStyle.Render("No events to group into sessions"))
+	default:
+		events := 0
+		for _, session := range s.sessions {
+			events += len(session.Events)
+		}
+		lines = append(lines, styles.DimTextStyle.Render(fmt.Sprintf("%d sessions · %d events", len(s.sessions), events)), "")
+
+		// Session list, windowed around the cursor
+		first := styles.Max(0, styles.Min(s.cursor-maxSessionListLines/2, len(s.sessions)-maxSessionListLines))
+		last := styles.Min(len(s.sessions), first+maxSessionListLines)
+		for i := first; i < last; i++ {
+			lines = append(lines, renderSessionLine(s.sessions[i], i == s.cursor, contentWidth))
+		}
+		lines = append(lines, "")
+
+		// Selected session, with the timeline scrolled to fill the rest
+		session := s.sessions[s.cursor]
+		lines = append(lines, renderSessionSummary(session)...)
+		lines = append(lines, "")
+
+		timeline := renderSessionTimeline(session, contentWidth)
+		available := styles.Max(1, height-len(lines)-10)
+		scroll := styles.Max(0, styles.Min(s.scroll, len(timeline)-available))
+		end := styles.Min(len(timeline), scroll+available)
+		lines = append(lines, timeline[scroll:end]...)
+		if end < len(timeline) {
+			lines = append(lines, styles.DimTextStyle.Render(fmt.Sprintf("  … %d more (J to scroll)", len(timeline)-end)))
+		}
+	}
+	lines = append(lines, "")
+
+	hints := []string{
+		styles.KeyStyle.Render("j/k") + " session",
+		styles.KeyStyle.Render("J/K") + " scroll timeline",
+		styles.KeyStyle.Render("c") + " copy session ID",
+		styles.KeyStyle.Render("r") + " refresh",
+		styles.KeyStyle.Render("Esc") + " close",
+	}
+	lines = append(lines, strings.Join(hints, " • "))
+
+	overlayStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorPrimary).
+		Padding(1, 2).
+		Width(width - 4).
+		Height(height - 4)
+
+	return overlayStyle.Render(strings.Join(lines, "\n"))
+}
+
+// renderSessionLine renders a session in the session list
+func renderSessionLine(session utils.Session, selected bool, width int) string {
+	id := session.ID
+	if id == "" {
+		id = "(no session)"
+	}
+
+	line := fmt.Sprintf("%s %5s %4d events  ",
+		session.Start().Local().Format("Jan 2 15:04"),
+		utils.FormatDuration(session.Duration()),
+		len(session.Events))
+	urls := displayURL(session.EntryURL()) + " → " + displayURL(session.ExitURL())
+	line += styles.DimTextStyle.Render(styles.TruncateString(urls, styles.Max(10, width-2-len(line))))
+
+	if selected {
+		return styles.HighlightTextStyle.Render("▶ ") + line
+	}
+	return "  " + line
+}
+
+// renderSessionSummary renders the start, end, duration and entry and exit
+// URLs of a session
+func renderSessionSummary(session utils.Session) []string {
+	id := session.ID
+	if id == "" {
+		id = styles.DimTextStyle.Render("(events without a $session_id)")
+	}
+
+	return []string{
+		styles.JSONKeyStyle.Render("Session: ") + id,
+		styles.JSONKeyStyle.Render("Start: ") + client.FormatEventTime(session.Start().Local()) +
+			styles.JSONKeyStyle.Render("  End: ") + client.FormatEventTime(session.End().Local()) +
+			styles.JSONKeyStyle.Render("  Duration: ") + utils.FormatDuration(session.Duration()),
+		styles.JSONKeyStyle.Render("Entry: ") + orNotSet(session.EntryURL()),
+		styles.JSONKeyStyle.Render("Exit: ") + orNotSet(session.ExitURL()),
+		styles.JSONKeyStyle.Render("Events: ") + fmt.Sprintf("%d", len(session.Events)),
+	}
+}
+
+// renderSessionTimeline renders a session's events oldest first, indented,
+// with the time elapsed since the previous event and the URL on page changes
+func renderSessionTimeline(session utils.Session, width int) []string {
+	lines := make([]string, 0, len(session.Events))
+	lastURL := ""
+	for i, event := range session.Events {
+		delta := "start"
+		if i > 0 {
+			delta = "+" + utils.FormatDuration(event.Timestamp.Sub(session.Events[i-1].Timestamp))
+		}
+
+		// Widths: indent 4, delta 6, time 8 and separators 4
+		name := styles.TruncateString(event.Event, styles.Max(10, width-22))
+		line := fmt.Sprintf("    %s %s  %s",
+			styles.DimTextStyle.Render(fmt.Sprintf("%6s", delta)),
+			styles.DimTextStyle.Render(event.Timestamp.Local().Format("15:04:05")),
+			styles.HighlightTextStyle.Render(name))
+
+		if current := utils.EventURL(event); current != "" && current != lastURL {
+			if room := width - 24 - len(name); room >= 10 {
+				line += "  " + styles.DimTextStyle.Render(styles.TruncateString(displayURL(current), room))
+			}
+			lastURL = current
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// displayURL shortens a URL to its path, query and fragment
+func displayURL(raw string) string {
+	if raw == "" {
+		return "-"
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return raw
+	}
+	path := parsed.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if parsed.RawQuery != "" {
+		path += "?" + parsed.RawQuery
+	}
+	if parsed.Fragment != "" {
+		path += "#" + parsed.Fragment
+	}
+	return path
+}
+
+// orNotSet returns value, or a dimmed placeholder if it is empty
+func orNotSet(value string) string {
+	if value == "" {
+		return styles.DimTextStyle.Render("(not set)")
+	}
+	return value
+}
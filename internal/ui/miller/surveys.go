@@ -0,0 +1,402 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/components"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// maxSurveyNameLen is the truncation length of survey names in the list
+	maxSurveyNameLen = 35
+
+	// maxSurveyResponses is the number of most recent responses fetched
+	maxSurveyResponses = 1000
+
+	// maxSurveyAnswerLen is the width of the answer column in distributions
+	maxSurveyAnswerLen = 24
+)
+
+// surveyResponses holds the fetched responses of a survey
+type surveyResponses struct {
+	responses []utils.SurveyResponse
+	loading   bool
+	err       error
+}
+
+// surveysMsg is sent when the surveys list and response counts have been fetched
+type surveysMsg struct {
+	surveys []client.Survey
+	counts  map[string]int // nil if responses couldn't be counted
+}
+
+// surveyResponsesMsg is sent when a survey's responses have been fetched
+type surveyResponsesMsg struct {
+	surveyID  string
+	responses []utils.SurveyResponse
+	err       error
+	export    bool // export the responses to CSV once fetched
+}
+
+func fetchSurveys(c client.PostHogClient) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		surveys, err := c.ListSurveys(ctx)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+
+		// Counts are a nicety: list surveys even if the query fails
+		counts, err := c.CountSurveyResponses(ctx)
+		if err != nil {
+			counts = nil
+		}
+		return surveysMsg{surveys: surveys, counts: counts}
+	}
+}
+
+// fetchSurveyResponses fetches a survey's most recent responses
+func fetchSurveyResponses(c client.PostHogClient, survey client.Survey, export bool) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		events, err := c.ListSurveyResponses(ctx, survey.ID, maxSurveyResponses)
+		if err != nil {
+			return surveyResponsesMsg{surveyID: survey.ID, err: err, export: export}
+		}
+		return surveyResponsesMsg{
+			surveyID:  survey.ID,
+			responses: utils.ParseSurveyResponses(survey, events),
+			export:    export,
+		}
+	}
+}
+
+// applySurveyResponses stores a survey's fetched responses and exports them
+// if that was requested
+func (m *Model) applySurveyResponses(msg surveyResponsesMsg) {
+	m.surveyResponses[msg.surveyID] = surveyResponses{responses: msg.responses, err: msg.err}
+	if !msg.export {
+		return
+	}
+	if msg.err != nil {
+		m.showClipboardFeedback("Export failed")
+		return
+	}
+	if survey, ok := m.inspectorData.(client.Survey); ok && survey.ID == msg.surveyID {
+		m.writeSurveyResponses(survey, msg.responses)
+	}
+}
+
+// exportSurveyResponses writes the inspected survey's responses to a CSV
+// file in the working directory, fetching them first if needed
+func (m *Model) exportSurveyResponses() tea.Cmd {
+	survey, ok := m.inspectorData.(client.Survey)
+	if !ok {
+		return nil
+	}
+
+	loaded, ok := m.surveyResponses[survey.ID]
+	switch {
+	case !ok || loaded.err != nil:
+		m.surveyResponses[survey.ID] = surveyResponses{loading: true}
+		return fetchSurveyResponses(m.client, survey, true)
+	case loaded.loading:
+		m.showClipboardFeedback("Responses are still loading")
+		return nil
+	}
+
+	m.writeSurveyResponses(survey, loaded.responses)
+	return nil
+}
+
+// writeSurveyResponses exports responses to a timestamped CSV file
+func (m *Model) writeSurveyResponses(survey client.Survey, responses []utils.SurveyResponse) {
+	if len(responses) == 0 {
+		m.showClipboardFeedback("No responses to export")
+		return
+	}
+
+	filename := fmt.Sprintf("survey-responses-%s.csv", time.Now().Format("20060102-150405"))
+	if err := utils.ExportSurveyResponsesCSV(survey, responses, filename); err != nil {
+		m.showClipboardFeedback("Export failed")
+		return
+	}
+	m.showClipboardFeedback("Exported " + filename)
+}
+
+// SurveyListItem wraps a client.Survey for list display
+type SurveyListItem struct {
+	Survey    client.Survey
+	Responses int // -1 if unknown
+}
+
+func (s SurveyListItem) RenderLine(width int, selected bool) string {
+	name := s.Survey.Name
+	if len(name) > maxSurveyNameLen {
+		name = styles.TruncateString(name, maxSurveyNameLen)
+	}
+
+	line := fmt.Sprintf("%s %s", surveyStatusIcon(s.Survey.Status()), name)
+	if s.Responses >= 0 {
+		line += " " + styles.DimTextStyle.Render(components.FormatCompactNumber(float64(s.Responses)))
+	}
+
+	if selected {
+		line = styles.SelectedListItemStyle.Render("▶ " + line)
+	} else {
+		line = styles.ListItemStyle.Render("  " + line)
+	}
+
+	return line
+}
+
+func (s SurveyListItem) GetID() string {
+	return s.Survey.ID
+}
+
+func (s SurveyListItem) GetInspectorData() interface{} {
+	return s.Survey
+}
+
+func (s SurveyListItem) GetDistinctID() string {
+	return "" // Surveys don't have distinct IDs
+}
+
+func (s SurveyListItem) GetSearchableText() string {
+	return s.Survey.Name + " " + s.Survey.Description + " " + string(s.Survey.Status())
+}
+
+// surveyStatusIcon returns a status indicator for a survey
+func surveyStatusIcon(status client.SurveyStatus) string {
+	switch status {
+	case client.SurveyRunning:
+		return styles.SuccessTextStyle.Render("●")
+	case client.SurveyComplete:
+		return styles.HighlightTextStyle.Render("✓")
+	case client.SurveyArchived:
+		return styles.DimTextStyle.Render("⊘")
+	default:
+		return styles.DimTextStyle.Render("○")
+	}
+}
+
+// renderSurveyInspectorScrollable renders a survey's status, questions and targeting
+func (m Model) renderSurveyInspectorScrollable(width, height int) string {
+	survey, ok := m.inspectorData.(client.Survey)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid survey data")
+	}
+
+	var lines []string
+
+	lines = append(lines, styles.JSONKeyStyle.Render("Name: ")+survey.Name)
+	lines = append(lines, styles.JSONKeyStyle.Render("Status: ")+surveyStatusIcon(survey.Status())+" "+string(survey.Status()))
+	if survey.Type != "" {
+		lines = append(lines, styles.JSONKeyStyle.Render("Type: ")+survey.Type)
+	}
+	if m.surveyResponseCounts != nil {
+		lines = append(lines, styles.JSONKeyStyle.Render("Responses: ")+fmt.Sprintf("%d", m.surveyResponseCounts[survey.ID]))
+	}
+	if survey.StartDate != nil {
+		lines = append(lines, styles.JSONKeyStyle.Render("Started: ")+client.FormatEventTime(survey.StartDate.Local()))
+	}
+	if survey.EndDate != nil {
+		lines = append(lines, styles.JSONKeyStyle.Render("Ended: ")+client.FormatEventTime(survey.EndDate.Local()))
+	}
+	if survey.Description != "" {
+		lines = append(lines, "")
+		lines = append(lines, survey.Description)
+	}
+	lines = append(lines, "")
+
+	// Questions
+	lines = append(lines, styles.JSONKeyStyle.Render("Questions:"))
+	if len(survey.Questions) == 0 {
+		lines = append(lines, styles.DimTextStyle.Render("  (none)"))
+	}
+	for i, question := range survey.Questions {
+		lines = append(lines, fmt.Sprintf("  %d. %s %s", i+1, question.Question, styles.DimTextStyle.Render(surveyQuestionKind(question))))
+		for _, choice := range question.Choices {
+			lines = append(lines, styles.DimTextStyle.Render("     • "+choice))
+		}
+	}
+	lines = append(lines, "")
+
+	// Targeting
+	lines = append(lines, styles.JSONKeyStyle.Render("Targeting:"))
+	targeting := surveyTargeting(survey)
+	if len(targeting) == 0 {
+		targeting = []string{styles.DimTextStyle.Render("everyone")}
+	}
+	for _, line := range targeting {
+		lines = append(lines, "  "+line)
+	}
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// surveyQuestionKind describes a question's type, e.g. "rating 1-5"
+func surveyQuestionKind(question client.SurveyQuestion) string {
+	switch question.Type {
+	case client.SurveyQuestionRating:
+		if question.Scale == 10 {
+			return "rating 0-10"
+		}
+		return fmt.Sprintf("rating 1-%d", question.Scale)
+	case client.SurveyQuestionSingleChoice:
+		return "single choice"
+	case client.SurveyQuestionMultipleChoice:
+		return "multiple choice"
+	case client.SurveyQuestionOpen:
+		return "open text"
+	default:
+		return question.Type
+	}
+}
+
+// surveyTargeting describes who a survey is shown to and where
+func surveyTargeting(survey client.Survey) []string {
+	var lines []string
+	if survey.LinkedFlag != nil {
+		lines = append(lines, "users with flag "+survey.LinkedFlag.Key+" enabled")
+	}
+	if survey.TargetingFlag != nil {
+		lines = append(lines, "users matching targeting flag "+survey.TargetingFlag.Key)
+	}
+	if c := survey.Conditions; c != nil {
+		if c.URL != "" {
+			match := c.URLMatchType
+			if match == "" || match == "icontains" {
+				match = "contains"
+			}
+			lines = append(lines, fmt.Sprintf("url %s %s", match, c.URL))
+		}
+		if c.Selector != "" {
+			lines = append(lines, "element matches "+c.Selector)
+		}
+		if c.Events != nil && len(c.Events.Values) > 0 {
+			names := make([]string, len(c.Events.Values))
+			for i, event := range c.Events.Values {
+				names[i] = event.Name
+			}
+			lines = append(lines, "after event "+strings.Join(names, " or "))
+		}
+		if c.SeenSurveyWaitPeriodInDays != nil {
+			lines = append(lines, fmt.Sprintf("not shown again within %d days of another survey", *c.SeenSurveyWaitPeriodInDays))
+		}
+	}
+	if survey.ResponsesLimit != nil {
+		lines = append(lines, fmt.Sprintf("stops after %d responses", *survey.ResponsesLimit))
+	}
+	return lines
+}
+
+// renderSurveyResponsesScrollable renders the answers to each question:
+// distribution bars for ratings and choices, a list for open text
+func (m Model) renderSurveyResponsesScrollable(width, height int) string {
+	survey, ok := m.inspectorData.(client.Survey)
+	if !ok {
+		return styles.ErrorTextStyle.Render("Error: Invalid survey data")
+	}
+
+	var lines []string
+
+	loaded, ok := m.surveyResponses[survey.ID]
+	switch {
+	case !ok || loaded.loading:
+		lines = append(lines, m.spinner.View()+" Loading responses...")
+	case loaded.err != nil:
+		lines = append(lines, styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", loaded.err)))
+	case len(loaded.responses) == 0:
+		lines = append(lines, styles.DimTextStyle.Render("No responses yet"))
+	default:
+		summary := fmt.Sprintf("%d responses", len(loaded.responses))
+		if len(loaded.responses) >= maxSurveyResponses {
+			summary = fmt.Sprintf("latest %d responses", maxSurveyResponses)
+		}
+		lines = append(lines, styles.DimTextStyle.Render(summary))
+
+		for i, question := range survey.Questions {
+			lines = append(lines, "")
+			lines = append(lines, styles.HighlightTextStyle.Render(fmt.Sprintf("%d. %s", i+1, question.Question)))
+			if question.Type == client.SurveyQuestionOpen || question.Type == client.SurveyQuestionLink {
+				lines = append(lines, renderSurveyTextAnswers(loaded.responses, i, width-8)...)
+			} else {
+				counts, answered := utils.SurveyDistribution(question, loaded.responses, i)
+				lines = append(lines, renderSurveyDistribution(counts, answered, width-8)...)
+			}
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.DimTextStyle.Render("Press Ctrl+S to export responses to CSV"))
+
+	// Build full content and update viewport
+	content := strings.Join(lines, "\n")
+	m.inspectorViewport.Width = width - 4
+	m.inspectorViewport.Height = height - 8
+	m.inspectorViewport.SetContent(content)
+
+	return m.inspectorViewport.View()
+}
+
+// renderSurveyDistribution renders one bar per answer with its count and
+// share of the responses that answered the question
+func renderSurveyDistribution(counts []utils.SurveyAnswerCount, answered, width int) []string {
+	if answered == 0 {
+		return []string{styles.DimTextStyle.Render("  No answers")}
+	}
+
+	top := 0
+	for _, c := range counts {
+		top = styles.Max(top, c.Count)
+	}
+	barWidth := styles.Max(5, width-maxSurveyAnswerLen-16)
+
+	var lines []string
+	for _, c := range counts {
+		lines = append(lines, fmt.Sprintf("  %-*s %s %5d %5.1f%%",
+			maxSurveyAnswerLen, styles.TruncateString(c.Answer, maxSurveyAnswerLen),
+			components.RenderBar(float64(c.Count)/float64(top), barWidth),
+			c.Count, float64(c.Count)/float64(answered)*100))
+	}
+	return lines
+}
+
+// renderSurveyTextAnswers lists the non-empty answers to a question, newest first
+func renderSurveyTextAnswers(responses []utils.SurveyResponse, index, width int) []string {
+	var lines []string
+	for _, response := range responses {
+		if index >= len(response.Answers) {
+			continue
+		}
+		answer := utils.SurveyAnswerText(response.Answers[index])
+		if answer == "" {
+			continue
+		}
+		lines = append(lines, "  "+styles.DimTextStyle.Render(response.Timestamp.Local().Format("Jan 2 15:04")+" "+
+			styles.TruncateString(response.DistinctID, 20)))
+		lines = append(lines, "    "+styles.TruncateString(answer, width-4))
+	}
+	if len(lines) == 0 {
+		return []string{styles.DimTextStyle.Render("  No answers")}
+	}
+	return lines
+}
@@ -0,0 +1,298 @@
+package miller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+	"github.com/aljazfarkas/lazyhog/internal/ui/components"
+	"github.com/aljazfarkas/lazyhog/internal/ui/styles"
+	"github.com/aljazfarkas/lazyhog/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// maxTrendBreakdowns is the number of breakdown values charted before the
+// rest are summed into "(other)"
+const maxTrendBreakdowns = 5
+
+// trendWindow is a time range the trend chart can show
+type trendWindow struct {
+	label    string
+	duration time.Duration
+	interval client.TrendInterval // default bucket size
+}
+
+// trendWindows are the time ranges cycled through in the trend chart
+var trendWindows = []trendWindow{
+	{label: "24h", duration: 24 * time.Hour, interval: client.TrendHourly},
+	{label: "7d", duration: 7 * 24 * time.Hour, interval: client.TrendHourly},
+	{label: "30d", duration: 30 * 24 * time.Hour, interval: client.TrendDaily},
+}
+
+// trendView is the ad-hoc trend chart of an event, shown as an overlay
+type trendView struct {
+	event      string
+	properties []string // property keys of the inspected event, for breakdowns
+	window     int      // index into trendWindows
+	interval   client.TrendInterval
+	breakdown  string
+	chart      *utils.TrendChart
+	loading    bool
+	err        error
+	seq        int // identifies the latest request, to drop stale responses
+}
+
+// trendMsg is sent when an event trend has been fetched
+type trendMsg struct {
+	seq   int
+	chart *utils.TrendChart
+	err   error
+}
+
+// fetchTrend counts an event per bucket over a window
+func fetchTrend(c client.PostHogClient, seq int, event string, window trendWindow, interval client.TrendInterval, breakdown string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		points, err := c.GetEventTrend(ctx, event, window.duration, interval, breakdown)
+		if err != nil {
+			return trendMsg{seq: seq, err: err}
+		}
+		chart := utils.BuildTrendChart(points, time.Now(), window.duration, interval, maxTrendBreakdowns)
+		return trendMsg{seq: seq, chart: &chart}
+	}
+}
+
+// openTrend opens the trend chart for the event under the cursor or in the inspector
+func (m *Model) openTrend() tea.Cmd {
+	event, ok := m.inspectorData.(client.Event)
+	if !ok {
+		items := m.getEffectiveListItems()
+		if m.listCursor >= len(items) {
+			return nil
+		}
+		if event, ok = items[m.listCursor].GetInspectorData().(client.Event); !ok {
+			return nil
+		}
+	}
+
+	m.trend = &trendView{
+		event:      event.Event,
+		properties: propertyKeys(event.Properties),
+		interval:   trendWindows[0].interval,
+	}
+	return tea.Batch(m.refreshTrend(), m.loadChartAnnotations())
+}
+
+// refreshTrend refetches the trend chart with its current settings
+func (m *Model) refreshTrend() tea.Cmd {
+	t := m.trend
+	t.seq++
+	t.loading = true
+	t.err = nil
+	return fetchTrend(m.client, t.seq, t.event, trendWindows[t.window], t.interval, t.breakdown)
+}
+
+// propertyKeys returns the sorted keys of a property map
+func propertyKeys(properties map[string]interface{}) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// handleTrendKeys handles keyboard input while the trend chart is shown
+func (m Model) handleTrendKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	t := m.trend
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc", "q":
+		m.trend = nil
+		return m, nil
+
+	case "w":
+		t.window = (t.window + 1) % len(trendWindows)
+		t.interval = trendWindows[t.window].interval
+		return m, m.refreshTrend()
+
+	case "i":
+		if t.interval == client.TrendHourly {
+			t.interval = client.TrendDaily
+		} else {
+			t.interval = client.TrendHourly
+		}
+		return m, m.refreshTrend()
+
+	case "b":
+		if len(t.properties) == 0 {
+			return m, nil
+		}
+		m.openPicker("Break down "+t.event+" by", t.properties, func(m *Model, key string) tea.Cmd {
+			if m.trend == nil {
+				return nil
+			}
+			m.trend.breakdown = key
+			return m.refreshTrend()
+		})
+		return m, nil
+
+	case "x":
+		if t.breakdown == "" {
+			return m, nil
+		}
+		t.breakdown = ""
+		return m, m.refreshTrend()
+
+	case "r":
+		return m, m.refreshTrend()
+	}
+
+	return m, nil
+}
+
+// applyTrend stores a fetched trend chart, ignoring stale responses
+func (m *Model) applyTrend(msg trendMsg) {
+	if m.trend == nil || msg.seq != m.trend.seq {
+		return
+	}
+	m.trend.loading = false
+	m.trend.chart = msg.chart
+	m.trend.err = msg.err
+}
+
+// renderTrendOverlay renders the trend chart overlay
+func (m Model) renderTrendOverlay(width, height int) string {
+	t := m.trend
+	var sb strings.Builder
+
+	sb.WriteString(styles.TitleStyle.Render("Trend: " + t.event))
+	sb.WriteString("\n")
+
+	subtitle := fmt.Sprintf("last %s · hourly", trendWindows[t.window].label)
+	if t.interval == client.TrendDaily {
+		subtitle = fmt.Sprintf("last %s · daily", trendWindows[t.window].label)
+	}
+	if t.breakdown != "" {
+		subtitle += " · by " + t.breakdown
+	}
+	sb.WriteString(styles.DimTextStyle.Render(subtitle))
+	sb.WriteString("\n\n")
+
+	chartWidth := width - 12
+	chartHeight := styles.Max(4, height-16)
+
+	switch {
+	case t.loading && t.chart == nil:
+		sb.WriteString(m.spinner.View() + " Loading trend...")
+	case t.err != nil:
+		sb.WriteString(styles.ErrorTextStyle.Render(fmt.Sprintf("Error: %v", t.err)))
+	default:
+		sb.WriteString(strings.Join(renderTrendChart(*t.chart, t.interval, m.chartAnnotations(), chartWidth, chartHeight), "\n"))
+		if t.loading {
+			sb.WriteString("\n" + m.spinner.View() + " Refreshing...")
+		}
+	}
+	sb.WriteString("\n\n")
+
+	hints := []string{
+		styles.KeyStyle.Render("w") + " window",
+		styles.KeyStyle.Render("i") + " hourly/daily",
+	}
+	if len(t.properties) > 0 {
+		hints = append(hints, styles.KeyStyle.Render("b")+" breakdown")
+	}
+	if t.breakdown != "" {
+		hints = append(hints, styles.KeyStyle.Render("x")+" clear breakdown")
+	}
+	hints = append(hints, styles.KeyStyle.Render("r")+" refresh", styles.KeyStyle.Render("Esc")+" close")
+	sb.WriteString(strings.Join(hints, " • "))
+
+	overlayStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorPrimary).
+		Padding(1, 2).
+		Width(width - 4).
+		Height(height - 4)
+
+	return overlayStyle.Render(sb.String())
+}
+
+// renderTrendChart renders a braille line chart with an x-axis and legend,
+// marking the annotations that fall within the chart
+func renderTrendChart(chart utils.TrendChart, interval client.TrendInterval, annotations []client.Annotation, width, height int) []string {
+	series := make([][]float64, len(chart.Lines))
+	for i, line := range chart.Lines {
+		series[i] = line.Values
+	}
+
+	axisWidth := components.ChartAxisWidth(series)
+	plotWidth := styles.Max(10, width-axisWidth)
+
+	lines := components.LineChart(series, plotWidth, height)
+	markers, legend := renderAnnotationMarkers(annotations, chart.Buckets, interval, plotWidth)
+	if markers != "" {
+		lines = append(lines, strings.Repeat(" ", axisWidth)+markers)
+	}
+	lines = append(lines, strings.Repeat(" ", axisWidth)+renderTimeAxis(chart.Buckets, interval, plotWidth))
+	lines = append(lines, "")
+
+	// Legend with totals
+	for i, line := range chart.Lines {
+		label := line.Label
+		if label == "" {
+			label = "count"
+		}
+		lines = append(lines, components.SeriesStyle(i).Render("━━ ")+label+
+			styles.DimTextStyle.Render(" "+components.FormatCompactNumber(line.Total)))
+	}
+
+	if len(legend) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, legend...)
+	}
+
+	return lines
+}
+
+// renderTimeAxis renders bucket labels at the start, middle and end of a
+// chart plotWidth cells wide
+func renderTimeAxis(buckets []time.Time, interval client.TrendInterval, plotWidth int) string {
+	if len(buckets) == 0 {
+		return ""
+	}
+
+	layout := "Jan 2 15:04"
+	if interval == client.TrendDaily {
+		layout = "Jan 2"
+	}
+
+	axis := []rune(strings.Repeat(" ", plotWidth))
+	place := func(i int) {
+		label := []rune(buckets[i].Local().Format(layout))
+		col := components.ChartColumn(i, len(buckets), plotWidth)
+		// Keep labels inside the axis
+		col = styles.Min(col, plotWidth-len(label))
+		col = styles.Max(col, 0)
+		copy(axis[col:], label)
+	}
+
+	place(0)
+	if len(buckets) > 2 {
+		place(len(buckets) / 2)
+	}
+	if len(buckets) > 1 {
+		place(len(buckets) - 1)
+	}
+
+	return styles.DimTextStyle.Render(string(axis))
+}
@@ -94,6 +94,10 @@ var (
 				Foreground(ColorSuccess).
 				Bold(true)
 
+	WarningTextStyle = lipgloss.NewStyle().
+				Foreground(ColorWarning).
+				Bold(true)
+
 	// Help text
 	HelpStyle = lipgloss.NewStyle().
 			Foreground(ColorDim).
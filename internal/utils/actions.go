@@ -0,0 +1,235 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// DescribeActionStep renders an action step as readable conditions, e.g.
+// "event $autocapture", "text = Sign up", "url contains /pricing"
+func DescribeActionStep(step client.ActionStep, cohortNames map[int]string) []string {
+	var lines []string
+
+	if event := stringValue(step.Event); event != "" {
+		lines = append(lines, "event "+event)
+	} else {
+		lines = append(lines, "any event")
+	}
+
+	describeMatch := func(field string, value, matching *string) {
+		if v := stringValue(value); v != "" {
+			lines = append(lines, fmt.Sprintf("%s %s %s", field, matchingVerb(stringValue(matching)), v))
+		}
+	}
+	describeMatch("url", step.URL, step.URLMatching)
+	describeMatch("text", step.Text, step.TextMatching)
+	describeMatch("link", step.Href, step.HrefMatching)
+
+	if selector := stringValue(step.Selector); selector != "" {
+		lines = append(lines, "element matches "+selector)
+	}
+	if tag := stringValue(step.TagName); tag != "" {
+		lines = append(lines, "element is <"+tag+">")
+	}
+
+	for _, filter := range step.Properties {
+		lines = append(lines, DescribePropertyFilter(filter, cohortNames))
+	}
+
+	return lines
+}
+
+// matchingVerb renders a step's url/text/href matching mode
+func matchingVerb(matching string) string {
+	switch matching {
+	case "exact":
+		return "="
+	case "regex":
+		return "matches"
+	default:
+		return "contains"
+	}
+}
+
+// CompileActionSteps compiles an action's steps into a HogQL condition on
+// the events table. Steps are OR'ed; the criteria within a step are AND'ed.
+// Criteria HogQL can't express (CSS selectors, element filters) are skipped
+// and described in the returned list so callers can warn that the
+// condition matches more events than the action. A step left without
+// criteria, such as one matching any event, matches every event.
+func CompileActionSteps(steps []client.ActionStep) (string, []string, error) {
+	var clauses, skipped []string
+
+	for i, step := range steps {
+		var conds []string
+
+		if event := stringValue(step.Event); event != "" {
+			conds = append(conds, "event = "+client.QuoteHogQLString(event))
+		}
+		if url := stringValue(step.URL); url != "" {
+			conds = append(conds, matchString(client.HogQLProperty("$current_url"), url, stringValue(step.URLMatching)))
+		}
+		if text := stringValue(step.Text); text != "" {
+			conds = append(conds, matchArray("elements_chain_texts", text, stringValue(step.TextMatching)))
+		}
+		if href := stringValue(step.Href); href != "" {
+			conds = append(conds, matchString("elements_chain_href", href, stringValue(step.HrefMatching)))
+		}
+		if tag := stringValue(step.TagName); tag != "" {
+			conds = append(conds, fmt.Sprintf("has(elements_chain_elements, %s)", client.QuoteHogQLString(tag)))
+		}
+		if selector := stringValue(step.Selector); selector != "" {
+			skipped = append(skipped, fmt.Sprintf("step %d: element matches %s", i+1, selector))
+		}
+
+		for _, filter := range step.Properties {
+			cond, ok := compilePropertyFilter(filter)
+			if !ok {
+				skipped = append(skipped, fmt.Sprintf("step %d: %s", i+1, DescribePropertyFilter(filter, nil)))
+				continue
+			}
+			conds = append(conds, cond)
+		}
+
+		if len(conds) == 0 {
+			conds = append(conds, "1 = 1")
+		}
+		clauses = append(clauses, "("+strings.Join(conds, " AND ")+")")
+	}
+
+	if len(clauses) == 0 {
+		return "", skipped, fmt.Errorf("action has no steps")
+	}
+	return strings.Join(clauses, " OR "), skipped, nil
+}
+
+// matchString matches a string expression exactly, by substring or by regex
+func matchString(expr, value, matching string) string {
+	quoted := client.QuoteHogQLString(value)
+	switch matching {
+	case "exact":
+		return fmt.Sprintf("%s = %s", expr, quoted)
+	case "regex":
+		return fmt.Sprintf("match(toString(%s), %s)", expr, quoted)
+	default:
+		return fmt.Sprintf("position(toString(%s), %s) > 0", expr, quoted)
+	}
+}
+
+// matchArray matches if any element of an array expression matches value
+func matchArray(expr, value, matching string) string {
+	quoted := client.QuoteHogQLString(value)
+	switch matching {
+	case "exact":
+		return fmt.Sprintf("has(%s, %s)", expr, quoted)
+	case "regex":
+		return fmt.Sprintf("arrayExists(x -> match(x, %s), %s)", quoted, expr)
+	default:
+		return fmt.Sprintf("arrayExists(x -> position(x, %s) > 0, %s)", quoted, expr)
+	}
+}
+
+// compilePropertyFilter compiles an event, person or cohort property filter
+// to a HogQL condition. Other filter types are not supported.
+func compilePropertyFilter(filter map[string]interface{}) (string, bool) {
+	filterType, _ := filter["type"].(string)
+	key, _ := filter["key"].(string)
+	operator, _ := filter["operator"].(string)
+
+	var field string
+	switch filterType {
+	case "", "event":
+		field = client.HogQLProperty(key)
+	case "person":
+		field = client.HogQLPersonProperty(key)
+	case "cohort":
+		id := toInt(filter["value"])
+		if id == 0 {
+			return "", false
+		}
+		if operator == "not_in" {
+			return fmt.Sprintf("person_id NOT IN COHORT %d", id), true
+		}
+		return fmt.Sprintf("person_id IN COHORT %d", id), true
+	default:
+		return "", false
+	}
+	if key == "" {
+		return "", false
+	}
+
+	values := filterValues(filter["value"])
+
+	switch operator {
+	case "", "exact", "is_not":
+		if len(values) == 0 {
+			return "", false
+		}
+		quoted := make([]string, len(values))
+		for i, v := range values {
+			quoted[i] = client.QuoteHogQLString(v)
+		}
+		in := "IN"
+		if operator == "is_not" {
+			in = "NOT IN"
+		}
+		return fmt.Sprintf("toString(%s) %s (%s)", field, in, strings.Join(quoted, ", ")), true
+
+	case "is_set":
+		return fmt.Sprintf("%s IS NOT NULL", field), true
+	case "is_not_set":
+		return fmt.Sprintf("%s IS NULL", field), true
+	}
+
+	if len(values) != 1 {
+		return "", false
+	}
+	value := values[0]
+
+	switch operator {
+	case "icontains":
+		return fmt.Sprintf("toString(%s) ILIKE %s", field, client.QuoteHogQLString("%"+value+"%")), true
+	case "not_icontains":
+		return fmt.Sprintf("NOT (toString(%s) ILIKE %s)", field, client.QuoteHogQLString("%"+value+"%")), true
+	case "regex":
+		return fmt.Sprintf("match(toString(%s), %s)", field, client.QuoteHogQLString(value)), true
+	case "not_regex":
+		return fmt.Sprintf("NOT match(toString(%s), %s)", field, client.QuoteHogQLString(value)), true
+	case "gt", "gte", "lt", "lte":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", false
+		}
+		symbol := map[string]string{"gt": ">", "gte": ">=", "lt": "<", "lte": "<="}[operator]
+		return fmt.Sprintf("toFloat(%s) %s %s", field, symbol, strconv.FormatFloat(number, 'f', -1, 64)), true
+	}
+
+	return "", false
+}
+
+// filterValues returns a filter value as a list of strings
+func filterValues(value interface{}) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, FormatJSONValue(item))
+		}
+		return values
+	default:
+		return []string{FormatJSONValue(v)}
+	}
+}
+
+// stringValue dereferences an optional string
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
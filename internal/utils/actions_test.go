@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestCompileActionSteps(t *testing.T) {
+	steps := []client.ActionStep{
+		{
+			Event:        strPtr("$autocapture"),
+			Text:         strPtr("Sign up"),
+			TextMatching: strPtr("exact"),
+			URL:          strPtr("/pricing"),
+			Selector:     strPtr("button.cta"),
+		},
+		{
+			Event: strPtr("signed_up"),
+			Properties: []map[string]interface{}{
+				{"key": "plan", "value": []interface{}{"pro", "team"}, "operator": "exact", "type": "event"},
+				{"key": "email", "value": "@acme.com", "operator": "icontains", "type": "person"},
+				{"key": "id", "value": float64(4), "type": "cohort"},
+				{"key": "seats", "value": "10", "operator": "gte", "type": "event"},
+				{"key": "$group_0", "value": "acme", "type": "group"},
+			},
+		},
+	}
+
+	condition, skipped, err := CompileActionSteps(steps)
+	if err != nil {
+		t.Fatalf("CompileActionSteps() error = %v", err)
+	}
+
+	want := "(event = '$autocapture' AND position(toString(properties.$current_url), '/pricing') > 0 AND has(elements_chain_texts, 'Sign up'))" +
+		" OR (event = 'signed_up' AND toString(properties.plan) IN ('pro', 'team')" +
+		" AND toString(person.properties.email) ILIKE '%@acme.com%'" +
+		" AND person_id IN COHORT 4 AND toFloat(properties.seats) >= 10)"
+	if condition != want {
+		t.Errorf("condition =\n%s\nwant\n%s", condition, want)
+	}
+
+	wantSkipped := []string{"step 1: element matches button.cta", "step 2: group $group_0 = acme"}
+	if !reflect.DeepEqual(skipped, wantSkipped) {
+		t.Errorf("skipped = %q, want %q", skipped, wantSkipped)
+	}
+}
+
+func TestCompileActionStepsMatchAll(t *testing.T) {
+	// A selector-only step and an any-event step match every event, as the
+	// condition may match more events than the action but never fewer
+	steps := []client.ActionStep{
+		{Selector: strPtr("#buy")},
+		{},
+		{Event: strPtr("purchased")},
+	}
+
+	condition, skipped, err := CompileActionSteps(steps)
+	if err != nil {
+		t.Fatalf("CompileActionSteps() error = %v", err)
+	}
+	if want := "(1 = 1) OR (1 = 1) OR (event = 'purchased')"; condition != want {
+		t.Errorf("condition = %s, want %s", condition, want)
+	}
+	if want := []string{"step 1: element matches #buy"}; !reflect.DeepEqual(skipped, want) {
+		t.Errorf("skipped = %q, want %q", skipped, want)
+	}
+
+	if _, _, err := CompileActionSteps(nil); err == nil {
+		t.Error("CompileActionSteps() expected an error for an action without steps")
+	}
+}
+
+func TestDescribeActionStep(t *testing.T) {
+	step := client.ActionStep{
+		Event:       strPtr("$pageview"),
+		URL:         strPtr("^/docs/.*"),
+		URLMatching: strPtr("regex"),
+		Properties: []map[string]interface{}{
+			{"key": "id", "value": float64(12), "type": "cohort"},
+		},
+	}
+
+	got := DescribeActionStep(step, map[int]string{12: "Beta users"})
+	want := []string{"event $pageview", "url matches ^/docs/.*", "in cohort Beta users (#12)"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DescribeActionStep() = %q, want %q", got, want)
+	}
+}
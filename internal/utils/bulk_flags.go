@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// DefaultBulkWorkers is the default number of concurrent flag updates
+const DefaultBulkWorkers = 4
+
+// FlagToggler is the subset of the API client needed to toggle flags
+type FlagToggler interface {
+	ToggleFlag(ctx context.Context, flagID int, active bool) error
+}
+
+// FlagToggle is a planned change of a flag's active state
+type FlagToggle struct {
+	Flag   client.FeatureFlag // flag as it was before the change
+	Active bool               // desired state
+}
+
+// FlagToggleResult is the outcome of applying a FlagToggle
+type FlagToggleResult struct {
+	FlagToggle
+	Err error
+}
+
+// FlagStateLabel renders a flag's active state
+func FlagStateLabel(active bool) string {
+	if active {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// MatchFlags returns the flags whose key matches a glob pattern such as "checkout-*"
+func MatchFlags(flags []client.FeatureFlag, pattern string) ([]client.FeatureFlag, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+
+	var matched []client.FeatureFlag
+	for _, flag := range flags {
+		if flag.Deleted {
+			continue
+		}
+		if ok, _ := path.Match(pattern, flag.Key); ok {
+			matched = append(matched, flag)
+		}
+	}
+	return matched, nil
+}
+
+// PlanFlagToggles plans setting every flag to active, skipping flags that
+// are already in that state
+func PlanFlagToggles(flags []client.FeatureFlag, active bool) []FlagToggle {
+	var toggles []FlagToggle
+	for _, flag := range flags {
+		if flag.Active != active {
+			toggles = append(toggles, FlagToggle{Flag: flag, Active: active})
+		}
+	}
+	return toggles
+}
+
+// ApplyFlagToggles applies the toggles concurrently with at most workers
+// requests in flight. Results are returned in the same order as toggles.
+func ApplyFlagToggles(ctx context.Context, t FlagToggler, toggles []FlagToggle, workers int) []FlagToggleResult {
+	if workers <= 0 {
+		workers = DefaultBulkWorkers
+	}
+
+	results := make([]FlagToggleResult, len(toggles))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(toggles); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				err := t.ToggleFlag(ctx, toggles[i].Flag.ID, toggles[i].Active)
+				results[i] = FlagToggleResult{FlagToggle: toggles[i], Err: err}
+			}
+		}()
+	}
+
+	for i := range toggles {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// RollbackToggles plans restoring the previous state of every flag that
+// was changed successfully
+func RollbackToggles(results []FlagToggleResult) []FlagToggle {
+	var toggles []FlagToggle
+	for _, r := range results {
+		if r.Err != nil {
+			continue
+		}
+		flag := r.Flag
+		flag.Active = r.Active
+		toggles = append(toggles, FlagToggle{Flag: flag, Active: !r.Active})
+	}
+	return toggles
+}
+
+// CountFailures returns the number of results that failed
+func CountFailures(results []FlagToggleResult) int {
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
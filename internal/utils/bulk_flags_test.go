@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// mockToggler records toggles and fails for configured flag IDs
+type mockToggler struct {
+	mu       sync.Mutex
+	calls    map[int]bool
+	failIDs  map[int]bool
+	inFlight int
+	maxSeen  int
+}
+
+func (m *mockToggler) ToggleFlag(ctx context.Context, flagID int, active bool) error {
+	m.mu.Lock()
+	m.inFlight++
+	if m.inFlight > m.maxSeen {
+		m.maxSeen = m.inFlight
+	}
+	m.mu.Unlock()
+
+	defer func() {
+		m.mu.Lock()
+		m.inFlight--
+		m.mu.Unlock()
+	}()
+
+	if m.failIDs[flagID] {
+		return fmt.Errorf("boom")
+	}
+
+	m.mu.Lock()
+	m.calls[flagID] = active
+	m.mu.Unlock()
+	return nil
+}
+
+func TestMatchFlags(t *testing.T) {
+	flags := []client.FeatureFlag{
+		{Key: "checkout-v2"},
+		{Key: "checkout-express"},
+		{Key: "new-checkout"},
+		{Key: "checkout-old", Deleted: true},
+	}
+
+	matched, err := MatchFlags(flags, "checkout-*")
+	if err != nil {
+		t.Fatalf("MatchFlags() error = %v", err)
+	}
+	if len(matched) != 2 {
+		t.Errorf("MatchFlags() matched %d flags, want 2", len(matched))
+	}
+
+	if _, err := MatchFlags(flags, "[invalid"); err == nil {
+		t.Error("MatchFlags() with invalid pattern should fail")
+	}
+}
+
+func TestApplyFlagToggles_AndRollback(t *testing.T) {
+	var flags []client.FeatureFlag
+	for i := 1; i <= 10; i++ {
+		flags = append(flags, client.FeatureFlag{ID: i, Key: fmt.Sprintf("flag-%d", i), Active: i != 10})
+	}
+
+	toggles := PlanFlagToggles(flags, false)
+	if len(toggles) != 9 {
+		t.Fatalf("PlanFlagToggles() planned %d toggles, want 9 (one already off)", len(toggles))
+	}
+
+	m := &mockToggler{calls: map[int]bool{}, failIDs: map[int]bool{3: true}}
+	results := ApplyFlagToggles(context.Background(), m, toggles, 2)
+
+	if len(results) != len(toggles) {
+		t.Fatalf("ApplyFlagToggles() returned %d results, want %d", len(results), len(toggles))
+	}
+	for i, r := range results {
+		if r.Flag.ID != toggles[i].Flag.ID {
+			t.Errorf("results[%d] is flag %d, want %d (order preserved)", i, r.Flag.ID, toggles[i].Flag.ID)
+		}
+	}
+	if CountFailures(results) != 1 {
+		t.Errorf("CountFailures() = %d, want 1", CountFailures(results))
+	}
+	if m.maxSeen > 2 {
+		t.Errorf("ApplyFlagToggles() ran %d requests concurrently, want at most 2", m.maxSeen)
+	}
+
+	rollback := RollbackToggles(results)
+	if len(rollback) != 8 {
+		t.Fatalf("RollbackToggles() planned %d toggles, want 8 (failed flag skipped)", len(rollback))
+	}
+	for _, toggle := range rollback {
+		if !toggle.Active || toggle.Flag.Active {
+			t.Errorf("rollback of flag %d = %v → %v, want false → true", toggle.Flag.ID, toggle.Flag.Active, toggle.Active)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// DefinitionUpdater is the subset of the API client needed to update event definitions
+type DefinitionUpdater interface {
+	UpdateEventDefinition(ctx context.Context, id string, update client.DefinitionUpdate) (*client.EventDefinition, error)
+}
+
+// DefinitionUpdateResult is the outcome of updating one event definition
+type DefinitionUpdateResult struct {
+	Definition client.EventDefinition // updated definition, or the original on error
+	Err        error
+}
+
+// UpdateEventDefinitions applies the same update to every definition
+// concurrently with at most workers requests in flight. Results are
+// returned in the same order as definitions.
+func UpdateEventDefinitions(ctx context.Context, u DefinitionUpdater, definitions []client.EventDefinition, update client.DefinitionUpdate, workers int) []DefinitionUpdateResult {
+	if workers <= 0 {
+		workers = DefaultBulkWorkers
+	}
+
+	results := make([]DefinitionUpdateResult, len(definitions))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(definitions); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				updated, err := u.UpdateEventDefinition(ctx, definitions[i].ID, update)
+				if err != nil {
+					results[i] = DefinitionUpdateResult{Definition: definitions[i], Err: err}
+				} else {
+					results[i] = DefinitionUpdateResult{Definition: *updated}
+				}
+			}
+		}()
+	}
+
+	for i := range definitions {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// ParseTags splits a comma-separated list of tags, trimming whitespace and
+// dropping empty and duplicate tags
+func ParseTags(s string) []string {
+	tags := []string{}
+	seen := make(map[string]bool)
+	for _, tag := range strings.Split(s, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
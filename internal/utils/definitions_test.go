@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// mockDefinitionUpdater applies updates to definitions and fails for configured IDs
+type mockDefinitionUpdater struct {
+	failIDs map[string]bool
+}
+
+func (m *mockDefinitionUpdater) UpdateEventDefinition(ctx context.Context, id string, update client.DefinitionUpdate) (*client.EventDefinition, error) {
+	if m.failIDs[id] {
+		return nil, fmt.Errorf("boom")
+	}
+	return &client.EventDefinition{ID: id, Hidden: *update.Hidden}, nil
+}
+
+func TestUpdateEventDefinitions(t *testing.T) {
+	definitions := []client.EventDefinition{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+	hidden := true
+	u := &mockDefinitionUpdater{failIDs: map[string]bool{"b": true}}
+
+	results := UpdateEventDefinitions(context.Background(), u, definitions, client.DefinitionUpdate{Hidden: &hidden}, 2)
+
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	for i, r := range results {
+		if r.Definition.ID != definitions[i].ID {
+			t.Errorf("results[%d].ID = %q, want %q (order must be preserved)", i, r.Definition.ID, definitions[i].ID)
+		}
+	}
+	if results[0].Err != nil || !results[0].Definition.Hidden {
+		t.Errorf("results[0] = %+v, want hidden definition", results[0])
+	}
+	if results[1].Err == nil || results[1].Definition.Hidden {
+		t.Errorf("results[1] = %+v, want error with original definition", results[1])
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	got := ParseTags(" growth, onboarding,,growth ")
+	want := []string{"growth", "onboarding"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseTags() = %v, want %v", got, want)
+	}
+
+	if got := ParseTags(""); len(got) != 0 || got == nil {
+		t.Errorf("ParseTags(\"\") = %#v, want empty non-nil slice", got)
+	}
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+)
+
+// FormatDuration renders a duration compactly using its largest unit,
+// e.g. "45s", "12m", "3h", "5d"
+func FormatDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
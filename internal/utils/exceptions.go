@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// StackFrame is a frame of an exception's stack trace
+type StackFrame struct {
+	Filename string
+	Function string
+	Line     int
+	Column   int
+	InApp    bool // part of the application rather than a library
+}
+
+// Location formats the frame's position as file:line:column
+func (f StackFrame) Location() string {
+	location := f.Filename
+	if location == "" {
+		location = "?"
+	}
+	if f.Line > 0 {
+		location += fmt.Sprintf(":%d", f.Line)
+		if f.Column > 0 {
+			location += fmt.Sprintf(":%d", f.Column)
+		}
+	}
+	return location
+}
+
+// ExceptionEntry is one exception of an $exception event. Chained
+// exceptions have several entries, the one raised last first.
+type ExceptionEntry struct {
+	Type   string
+	Value  string
+	Frames []StackFrame // outermost call first, as captured by PostHog
+}
+
+// ParseExceptionList extracts the exceptions of an $exception event from
+// $exception_list, falling back to the legacy $exception_type and
+// $exception_message properties without frames
+func ParseExceptionList(properties map[string]interface{}) []ExceptionEntry {
+	list := properties["$exception_list"]
+
+	// The list is stored as a JSON string by some SDKs
+	if raw, ok := list.(string); ok {
+		if err := json.Unmarshal([]byte(raw), &list); err != nil {
+			list = nil
+		}
+	}
+
+	var entries []ExceptionEntry
+	if items, ok := list.([]interface{}); ok {
+		for _, item := range items {
+			exception, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			entry := ExceptionEntry{
+				Type:  stringField(exception, "type"),
+				Value: stringField(exception, "value"),
+			}
+			if stacktrace, ok := exception["stacktrace"].(map[string]interface{}); ok {
+				entry.Frames = parseStackFrames(stacktrace["frames"])
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	if len(entries) == 0 {
+		exceptionType := stringField(properties, "$exception_type")
+		message := stringField(properties, "$exception_message")
+		if exceptionType != "" || message != "" {
+			entries = append(entries, ExceptionEntry{Type: exceptionType, Value: message})
+		}
+	}
+	return entries
+}
+
+// parseStackFrames parses raw or resolved stack frames, preferring resolved
+// names and positions
+func parseStackFrames(raw interface{}) []StackFrame {
+	items, _ := raw.([]interface{})
+	frames := make([]StackFrame, 0, len(items))
+	for _, item := range items {
+		frame, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		inApp, _ := frame["in_app"].(bool)
+		frames = append(frames, StackFrame{
+			Filename: firstStringField(frame, "filename", "abs_path", "source", "module"),
+			Function: firstStringField(frame, "resolved_name", "function", "mangled_name"),
+			Line:     firstIntField(frame, "lineno", "line"),
+			Column:   firstIntField(frame, "colno", "column"),
+			InApp:    inApp,
+		})
+	}
+	return frames
+}
+
+// stringField returns a string field of a JSON object, or ""
+func stringField(object map[string]interface{}, key string) string {
+	value, _ := object[key].(string)
+	return value
+}
+
+// firstStringField returns the first non-empty string among fields of a JSON object
+func firstStringField(object map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if value := stringField(object, key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// firstIntField returns the first positive number among fields of a JSON object
+func firstIntField(object map[string]interface{}, keys ...string) int {
+	for _, key := range keys {
+		// Numbers are decoded from JSON as float64
+		if value, ok := object[key].(float64); ok && value > 0 {
+			return int(value)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,55 @@
+package utils
+
+import "testing"
+
+func TestParseExceptionList(t *testing.T) {
+	properties := map[string]interface{}{
+		"$exception_list": []interface{}{
+			map[string]interface{}{
+				"type":  "TypeError",
+				"value": "x is undefined",
+				"stacktrace": map[string]interface{}{
+					"type": "resolved",
+					"frames": []interface{}{
+						map[string]interface{}{"filename": "node_modules/react-dom.js", "function": "invoke", "lineno": float64(10), "in_app": false},
+						map[string]interface{}{"source": "src/cart.ts", "resolved_name": "checkout", "line": float64(42), "column": float64(7), "in_app": true},
+					},
+				},
+			},
+		},
+	}
+
+	entries := ParseExceptionList(properties)
+	if len(entries) != 1 || entries[0].Type != "TypeError" || entries[0].Value != "x is undefined" {
+		t.Fatalf("ParseExceptionList() = %+v, want a single TypeError", entries)
+	}
+
+	frames := entries[0].Frames
+	if len(frames) != 2 {
+		t.Fatalf("ParseExceptionList() returned %d frames, want 2", len(frames))
+	}
+	if got := frames[1].Location(); got != "src/cart.ts:42:7" || frames[1].Function != "checkout" || !frames[1].InApp {
+		t.Errorf("second frame = %+v at %q, want in-app checkout at src/cart.ts:42:7", frames[1], got)
+	}
+	if got := frames[0].Location(); got != "node_modules/react-dom.js:10" || frames[0].InApp {
+		t.Errorf("first frame = %+v at %q, want library frame at node_modules/react-dom.js:10", frames[0], got)
+	}
+}
+
+func TestParseExceptionList_Fallbacks(t *testing.T) {
+	encoded := map[string]interface{}{
+		"$exception_list": `[{"type": "ValueError", "value": "bad", "stacktrace": {"frames": [{"filename": "app.py", "function": "run", "lineno": 3, "in_app": true}]}}]`,
+	}
+	if entries := ParseExceptionList(encoded); len(entries) != 1 || len(entries[0].Frames) != 1 || entries[0].Frames[0].Location() != "app.py:3" {
+		t.Errorf("ParseExceptionList() of a JSON string = %+v, want one ValueError frame at app.py:3", entries)
+	}
+
+	legacy := map[string]interface{}{"$exception_type": "Error", "$exception_message": "boom"}
+	if entries := ParseExceptionList(legacy); len(entries) != 1 || entries[0].Value != "boom" || entries[0].Frames != nil {
+		t.Errorf("ParseExceptionList() of legacy properties = %+v, want Error: boom without frames", entries)
+	}
+
+	if entries := ParseExceptionList(map[string]interface{}{}); entries != nil {
+		t.Errorf("ParseExceptionList() without exception properties = %+v, want none", entries)
+	}
+}
@@ -14,6 +14,20 @@ func ExportToCSV(result *client.QueryResult, filename string) error {
 		return fmt.Errorf("no result to export")
 	}
 
+	rows := make([][]string, len(result.Results))
+	for i, row := range result.Results {
+		strRow := make([]string, len(row))
+		for j, cell := range row {
+			strRow[j] = fmt.Sprintf("%v", cell)
+		}
+		rows[i] = strRow
+	}
+
+	return WriteCSV(filename, result.Columns, rows)
+}
+
+// WriteCSV writes a header and rows to a CSV file
+func WriteCSV(filename string, header []string, rows [][]string) error {
 	file, err := os.Create(filename)
 	if err != nil {
 		return fmt.Errorf("failed to create file: %w", err)
@@ -24,17 +38,13 @@ func ExportToCSV(result *client.QueryResult, filename string) error {
 	defer writer.Flush()
 
 	// Write header
-	if err := writer.Write(result.Columns); err != nil {
+	if err := writer.Write(header); err != nil {
 		return fmt.Errorf("failed to write header: %w", err)
 	}
 
 	// Write rows
-	for _, row := range result.Results {
-		strRow := make([]string, len(row))
-		for i, cell := range row {
-			strRow[i] = fmt.Sprintf("%v", cell)
-		}
-		if err := writer.Write(strRow); err != nil {
+	for _, row := range rows {
+		if err := writer.Write(row); err != nil {
 			return fmt.Errorf("failed to write row: %w", err)
 		}
 	}
@@ -0,0 +1,243 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// filterOperators maps PostHog property filter operators to readable forms
+var filterOperators = map[string]string{
+	"exact":          "=",
+	"is_not":         "≠",
+	"icontains":      "contains",
+	"not_icontains":  "does not contain",
+	"regex":          "matches",
+	"not_regex":      "does not match",
+	"gt":             ">",
+	"gte":            "≥",
+	"lt":             "<",
+	"lte":            "≤",
+	"is_set":         "is set",
+	"is_not_set":     "is not set",
+	"is_date_before": "before",
+	"is_date_after":  "after",
+	"is_date_exact":  "on",
+	"in":             "in",
+	"not_in":         "not in",
+}
+
+// DescribePropertyFilter renders a single PostHog property filter as a
+// readable condition, e.g. "email contains @acme.com" or "in cohort Beta
+// users (#12)". cohortNames resolves cohort IDs and may be nil.
+func DescribePropertyFilter(filter map[string]interface{}, cohortNames map[int]string) string {
+	filterType, _ := filter["type"].(string)
+	key := FormatJSONValue(filter["key"])
+	operator, _ := filter["operator"].(string)
+	negation, _ := filter["negation"].(bool)
+
+	switch filterType {
+	case "cohort":
+		id := toInt(filter["value"])
+		desc := fmt.Sprintf("in cohort #%d", id)
+		if name, ok := cohortNames[id]; ok {
+			desc = fmt.Sprintf("in cohort %s (#%d)", name, id)
+		}
+		if negation || operator == "not_in" {
+			desc = "not " + desc
+		}
+		return desc
+
+	case "behavioral":
+		return describeBehavioralFilter(filter, negation)
+	}
+
+	if operator == "" {
+		operator = "exact"
+	}
+	readable, ok := filterOperators[operator]
+	if !ok {
+		readable = operator
+	}
+
+	prefix := ""
+	if filterType == "group" {
+		prefix = "group "
+	} else if filterType == "event" {
+		prefix = "event "
+	}
+
+	if operator == "is_set" || operator == "is_not_set" {
+		return fmt.Sprintf("%s%s %s", prefix, key, readable)
+	}
+	return fmt.Sprintf("%s%s %s %s", prefix, key, readable, describeFilterValue(filter["value"]))
+}
+
+// describeBehavioralFilter renders cohort filters on what a person did,
+// e.g. "performed event signup ≥ 3 times in the last 30 days"
+func describeBehavioralFilter(filter map[string]interface{}, negation bool) string {
+	value, _ := filter["value"].(string)
+	key := FormatJSONValue(filter["key"])
+
+	verb := strings.ReplaceAll(value, "_", " ")
+	switch value {
+	case "performed_event", "performed_event_multiple":
+		verb = "performed event"
+	case "performed_event_first_time":
+		verb = "performed event for the first time"
+	}
+	if negation {
+		verb = "did not " + strings.TrimPrefix(verb, "performed ")
+		verb = strings.Replace(verb, "did not event", "did not perform event", 1)
+	}
+
+	desc := fmt.Sprintf("%s %s", verb, key)
+
+	if value == "performed_event_multiple" {
+		if op, ok := filterOperators[FormatJSONValue(filter["operator"])]; ok {
+			desc += fmt.Sprintf(" %s %d times", op, toInt(filter["operator_value"]))
+		}
+	}
+
+	if timeValue := toInt(filter["time_value"]); timeValue > 0 {
+		interval, _ := filter["time_interval"].(string)
+		if interval == "" {
+			interval = "day"
+		}
+		desc += fmt.Sprintf(" in the last %d %ss", timeValue, interval)
+	}
+
+	return desc
+}
+
+// describeFilterValue renders a filter value, joining lists with "or"
+func describeFilterValue(value interface{}) string {
+	if list, ok := value.([]interface{}); ok {
+		parts := make([]string, len(list))
+		for i, v := range list {
+			parts[i] = FormatJSONValue(v)
+		}
+		return strings.Join(parts, " or ")
+	}
+	return FormatJSONValue(value)
+}
+
+// DescribeFilterGroup renders a nested PostHog filter group
+// ({"type": "AND"|"OR", "values": [...]}) as indented lines
+func DescribeFilterGroup(group map[string]interface{}, cohortNames map[int]string, indent string) []string {
+	values, _ := group["values"].([]interface{})
+	groupType, _ := group["type"].(string)
+
+	header := "ALL of:"
+	if strings.EqualFold(groupType, "OR") {
+		header = "ANY of:"
+	}
+	lines := []string{indent + header}
+
+	for _, v := range values {
+		child, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, nested := child["values"]; nested {
+			lines = append(lines, DescribeFilterGroup(child, cohortNames, indent+"  ")...)
+		} else {
+			lines = append(lines, indent+"  • "+DescribePropertyFilter(child, cohortNames))
+		}
+	}
+
+	if len(values) == 0 {
+		lines = append(lines, indent+"  (no conditions)")
+	}
+
+	return lines
+}
+
+// DescribePropertyList renders a flat list of property filters joined with AND
+func DescribePropertyList(properties []interface{}, cohortNames map[int]string) string {
+	parts := make([]string, 0, len(properties))
+	for _, p := range properties {
+		if filter, ok := p.(map[string]interface{}); ok {
+			parts = append(parts, DescribePropertyFilter(filter, cohortNames))
+		}
+	}
+	return strings.Join(parts, " AND ")
+}
+
+// DescribeCohort renders a cohort's membership rules as indented lines,
+// from its filters or, for older cohorts, its legacy groups
+func DescribeCohort(cohort client.Cohort, cohortNames map[int]string) []string {
+	if cohort.IsStatic {
+		return []string{"Static list of persons (uploaded or created from a selection)"}
+	}
+
+	if properties, ok := cohort.Filters["properties"].(map[string]interface{}); ok {
+		return DescribeFilterGroup(properties, cohortNames, "")
+	}
+
+	if len(cohort.Groups) > 0 {
+		lines := []string{"ANY of:"}
+		for _, group := range cohort.Groups {
+			if properties, ok := group["properties"].([]interface{}); ok && len(properties) > 0 {
+				lines = append(lines, "  • "+DescribePropertyList(properties, cohortNames))
+				continue
+			}
+			if actionID := toInt(group["action_id"]); actionID > 0 {
+				desc := fmt.Sprintf("  • performed action #%d", actionID)
+				if days := toInt(group["days"]); days > 0 {
+					desc += fmt.Sprintf(" in the last %d days", days)
+				}
+				lines = append(lines, desc)
+			}
+		}
+		return lines
+	}
+
+	return []string{"(no conditions)"}
+}
+
+// CohortIDsInFilters returns the IDs of all cohorts referenced anywhere in a
+// decoded filter structure, in order of first appearance
+func CohortIDsInFilters(filters interface{}) []int {
+	var ids []int
+	seen := make(map[int]bool)
+
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch node := v.(type) {
+		case map[string]interface{}:
+			if node["type"] == "cohort" {
+				if id := toInt(node["value"]); id > 0 && !seen[id] {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+			for _, child := range node {
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range node {
+				walk(child)
+			}
+		}
+	}
+	walk(filters)
+
+	return ids
+}
+
+// toInt converts a decoded JSON number (or numeric string) to an int
+func toInt(value interface{}) int {
+	switch v := value.(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	case string:
+		var n int
+		fmt.Sscanf(v, "%d", &n)
+		return n
+	}
+	return 0
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+func TestDescribePropertyFilter(t *testing.T) {
+	names := map[int]string{12: "Beta users"}
+
+	tests := []struct {
+		name   string
+		filter map[string]interface{}
+		want   string
+	}{
+		{
+			name:   "contains",
+			filter: map[string]interface{}{"key": "email", "operator": "icontains", "value": "@acme.com", "type": "person"},
+			want:   "email contains @acme.com",
+		},
+		{
+			name:   "list value defaults to exact",
+			filter: map[string]interface{}{"key": "plan", "value": []interface{}{"pro", "team"}, "type": "person"},
+			want:   "plan = pro or team",
+		},
+		{
+			name:   "is set",
+			filter: map[string]interface{}{"key": "company", "operator": "is_set", "type": "person"},
+			want:   "company is set",
+		},
+		{
+			name:   "named cohort",
+			filter: map[string]interface{}{"key": "id", "value": float64(12), "type": "cohort"},
+			want:   "in cohort Beta users (#12)",
+		},
+		{
+			name:   "unknown cohort",
+			filter: map[string]interface{}{"key": "id", "value": float64(7), "type": "cohort", "negation": true},
+			want:   "not in cohort #7",
+		},
+		{
+			name: "behavioral",
+			filter: map[string]interface{}{
+				"key": "signed_up", "value": "performed_event_multiple", "type": "behavioral",
+				"operator": "gte", "operator_value": float64(3), "time_value": float64(30), "time_interval": "day",
+			},
+			want: "performed event signed_up ≥ 3 times in the last 30 days",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DescribePropertyFilter(tt.filter, names); got != tt.want {
+				t.Errorf("DescribePropertyFilter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDescribeCohort(t *testing.T) {
+	cohort := client.Cohort{
+		Filters: map[string]interface{}{
+			"properties": map[string]interface{}{
+				"type": "OR",
+				"values": []interface{}{
+					map[string]interface{}{
+						"type": "AND",
+						"values": []interface{}{
+							map[string]interface{}{"key": "email", "operator": "icontains", "value": "@acme.com", "type": "person"},
+							map[string]interface{}{"key": "id", "value": float64(12), "type": "cohort"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	want := []string{
+		"ANY of:",
+		"  ALL of:",
+		"    • email contains @acme.com",
+		"    • in cohort #12",
+	}
+	if got := DescribeCohort(cohort, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("DescribeCohort() = %q, want %q", got, want)
+	}
+
+	if got := CohortIDsInFilters(cohort.Filters); !reflect.DeepEqual(got, []int{12}) {
+		t.Errorf("CohortIDsInFilters() = %v, want [12]", got)
+	}
+}
@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// FlagAuditRow is one row of the stale and unused flag report
+type FlagAuditRow struct {
+	Flag          client.FeatureFlag
+	Evaluations   int       // $feature_flag_called events within the window
+	LastEvaluated time.Time // zero if not evaluated within the window
+	Rollout       client.RolloutState
+	Age           time.Duration // time since creation, zero if unknown
+	Candidate     bool          // suggested for removal
+	Reasons       []string      // why the flag is a removal candidate
+}
+
+// FlagAuditSort identifies the column a flag audit is sorted by
+type FlagAuditSort int
+
+const (
+	FlagAuditSortCandidate FlagAuditSort = iota // removal candidates first, least recently evaluated first
+	FlagAuditSortKey
+	FlagAuditSortEvaluations
+	FlagAuditSortLastEvaluated
+	FlagAuditSortAge
+	FlagAuditSortRollout
+)
+
+// flagAuditSortNames maps sort columns to the names accepted on the command line
+var flagAuditSortNames = []string{"candidate", "key", "evaluations", "last-evaluated", "age", "rollout"}
+
+// String returns the command-line name of the sort column
+func (s FlagAuditSort) String() string {
+	if int(s) < len(flagAuditSortNames) {
+		return flagAuditSortNames[s]
+	}
+	return "unknown"
+}
+
+// Next returns the following sort column, wrapping around
+func (s FlagAuditSort) Next() FlagAuditSort {
+	return FlagAuditSort((int(s) + 1) % len(flagAuditSortNames))
+}
+
+// ParseFlagAuditSort parses a sort column name such as "last-evaluated"
+func ParseFlagAuditSort(name string) (FlagAuditSort, error) {
+	for i, n := range flagAuditSortNames {
+		if n == name {
+			return FlagAuditSort(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown sort column %q (valid: %s)", name, strings.Join(flagAuditSortNames, ", "))
+}
+
+// BuildFlagAudit combines flags with their usage over the last days days.
+// A flag older than the window is a removal candidate if it was never
+// evaluated, is fully off, or is rolled out to 100% of users.
+func BuildFlagAudit(flags []client.FeatureFlag, usage map[string]client.FlagUsage, days int, now time.Time) []FlagAuditRow {
+	window := time.Duration(days) * 24 * time.Hour
+
+	rows := make([]FlagAuditRow, 0, len(flags))
+	for _, flag := range flags {
+		if flag.Deleted {
+			continue
+		}
+
+		row := FlagAuditRow{
+			Flag:    flag,
+			Rollout: flag.RolloutState(),
+		}
+
+		if u, ok := usage[flag.Key]; ok {
+			row.Evaluations = u.Evaluations
+			row.LastEvaluated = u.LastEvaluated
+		}
+
+		if created, err := time.Parse(time.RFC3339, flag.CreatedAt); err == nil {
+			row.Age = now.Sub(created)
+		}
+
+		// Flags younger than the window may simply not be deployed yet
+		if row.Age == 0 || row.Age >= window {
+			if row.Evaluations == 0 {
+				row.Reasons = append(row.Reasons, fmt.Sprintf("not evaluated in %dd", days))
+			}
+			switch row.Rollout {
+			case client.RolloutOff:
+				row.Reasons = append(row.Reasons, "fully off")
+			case client.RolloutFull:
+				row.Reasons = append(row.Reasons, "100% rolled out")
+			}
+		}
+		row.Candidate = len(row.Reasons) > 0
+
+		rows = append(rows, row)
+	}
+
+	SortFlagAudit(rows, FlagAuditSortCandidate)
+	return rows
+}
+
+// SortFlagAudit sorts rows in place by the given column. Counts, recency and
+// age sort in the order most useful for spotting dead flags.
+func SortFlagAudit(rows []FlagAuditRow, by FlagAuditSort) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		switch by {
+		case FlagAuditSortKey:
+			return a.Flag.Key < b.Flag.Key
+		case FlagAuditSortEvaluations:
+			return a.Evaluations < b.Evaluations
+		case FlagAuditSortLastEvaluated:
+			return a.LastEvaluated.Before(b.LastEvaluated)
+		case FlagAuditSortAge:
+			return a.Age > b.Age
+		case FlagAuditSortRollout:
+			return a.Rollout < b.Rollout
+		default:
+			if a.Candidate != b.Candidate {
+				return a.Candidate
+			}
+			if !a.LastEvaluated.Equal(b.LastEvaluated) {
+				return a.LastEvaluated.Before(b.LastEvaluated)
+			}
+			return a.Flag.Key < b.Flag.Key
+		}
+	})
+}
+
+// FormatLastEvaluated renders the last evaluation time relative to now
+func (r FlagAuditRow) FormatLastEvaluated(now time.Time) string {
+	if r.LastEvaluated.IsZero() {
+		return "never"
+	}
+	return FormatDuration(now.Sub(r.LastEvaluated)) + " ago"
+}
+
+// FormatAge renders the flag's age, or "?" if its creation time is unknown
+func (r FlagAuditRow) FormatAge() string {
+	if r.Age == 0 {
+		return "?"
+	}
+	return FormatDuration(r.Age)
+}
+
+// ExportFlagAuditCSV writes a flag audit report to a CSV file
+func ExportFlagAuditCSV(rows []FlagAuditRow, filename string) error {
+	header := []string{"key", "name", "active", "rollout", "evaluations", "last_evaluated", "created_at", "age_days", "candidate", "reasons"}
+
+	records := make([][]string, len(rows))
+	for i, row := range rows {
+		lastEvaluated := ""
+		if !row.LastEvaluated.IsZero() {
+			lastEvaluated = row.LastEvaluated.Format(time.RFC3339)
+		}
+		records[i] = []string{
+			row.Flag.Key,
+			row.Flag.Name,
+			strconv.FormatBool(row.Flag.Active),
+			string(row.Rollout),
+			strconv.Itoa(row.Evaluations),
+			lastEvaluated,
+			row.Flag.CreatedAt,
+			strconv.Itoa(int(row.Age.Hours() / 24)),
+			strconv.FormatBool(row.Candidate),
+			strings.Join(row.Reasons, "; "),
+		}
+	}
+
+	return WriteCSV(filename, header, records)
+}
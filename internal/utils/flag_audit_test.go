@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+func TestBuildFlagAudit(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	old := now.AddDate(0, -6, 0).Format(time.RFC3339)
+	recent := now.AddDate(0, 0, -2).Format(time.RFC3339)
+	partial := map[string]interface{}{"groups": []interface{}{
+		map[string]interface{}{"rollout_percentage": float64(50), "properties": []interface{}{}},
+	}}
+
+	flags := []client.FeatureFlag{
+		{Key: "in-use", Active: true, Filters: partial, CreatedAt: old},
+		{Key: "unused", Active: true, Filters: partial, CreatedAt: old},
+		{Key: "brand-new", Active: true, Filters: partial, CreatedAt: recent},
+		{Key: "switched-off", Active: false, CreatedAt: old},
+		{Key: "deleted", Deleted: true, CreatedAt: old},
+	}
+	usage := map[string]client.FlagUsage{
+		"in-use":       {Key: "in-use", Evaluations: 500, LastEvaluated: now.Add(-time.Hour)},
+		"switched-off": {Key: "switched-off", Evaluations: 3, LastEvaluated: now.AddDate(0, 0, -20)},
+	}
+
+	rows := BuildFlagAudit(flags, usage, 30, now)
+
+	if len(rows) != 4 {
+		t.Fatalf("BuildFlagAudit() returned %d rows, want 4 (deleted flags excluded)", len(rows))
+	}
+
+	byKey := make(map[string]FlagAuditRow)
+	for _, row := range rows {
+		byKey[row.Flag.Key] = row
+	}
+
+	if byKey["in-use"].Candidate {
+		t.Errorf("in-use flag should not be a candidate, reasons: %v", byKey["in-use"].Reasons)
+	}
+	if byKey["brand-new"].Candidate {
+		t.Errorf("flags younger than the window should not be candidates, reasons: %v", byKey["brand-new"].Reasons)
+	}
+	if !byKey["unused"].Candidate {
+		t.Error("never evaluated flag should be a candidate")
+	}
+	if row := byKey["switched-off"]; !row.Candidate || row.Rollout != client.RolloutOff {
+		t.Errorf("switched-off flag = %+v, want off candidate", row)
+	}
+
+	// Candidates first, never-evaluated before recently evaluated
+	if rows[0].Flag.Key != "unused" || rows[1].Flag.Key != "switched-off" {
+		t.Errorf("BuildFlagAudit() order = %s, %s, want unused, switched-off", rows[0].Flag.Key, rows[1].Flag.Key)
+	}
+}
+
+func TestParseFlagAuditSort(t *testing.T) {
+	for i, name := range flagAuditSortNames {
+		got, err := ParseFlagAuditSort(name)
+		if err != nil || got != FlagAuditSort(i) {
+			t.Errorf("ParseFlagAuditSort(%q) = %v, %v", name, got, err)
+		}
+		if got.String() != name {
+			t.Errorf("FlagAuditSort(%d).String() = %q, want %q", i, got.String(), name)
+		}
+	}
+
+	if _, err := ParseFlagAuditSort("bogus"); err == nil {
+		t.Error("ParseFlagAuditSort(bogus) should fail")
+	}
+}
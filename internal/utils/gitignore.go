@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ignoreRule is a single pattern from a .gitignore file
+type ignoreRule struct {
+	base     string // slash-separated directory of the .gitignore, relative to the root ("" for the root)
+	pattern  string
+	negate   bool // pattern started with "!"
+	dirOnly  bool // pattern ended with "/"
+	anchored bool // pattern contains a "/" before its end and only matches relative to base
+}
+
+// GitIgnore matches paths against the rules of the .gitignore files in a tree
+type GitIgnore struct {
+	rules []ignoreRule
+}
+
+// LoadDir reads the .gitignore file in dir, if any. rel is dir relative to
+// the root of the tree in slash form ("" for the root itself).
+func (g *GitIgnore) LoadDir(dir, rel string) error {
+	f, err := os.Open(filepath.Join(dir, ".gitignore"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		g.AddPattern(rel, scanner.Text())
+	}
+	return scanner.Err()
+}
+
+// AddPattern adds a single .gitignore line that applies below base
+func (g *GitIgnore) AddPattern(base, line string) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return
+	}
+
+	rule := ignoreRule{base: base}
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	}
+	line = strings.TrimPrefix(line, "\\")
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimSuffix(line, "/")
+	}
+	if strings.Contains(line, "/") {
+		rule.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+	if line == "" {
+		return
+	}
+	rule.pattern = line
+
+	g.rules = append(g.rules, rule)
+}
+
+// Ignored reports whether the slash-separated path rel (relative to the root)
+// is ignored. The last matching rule wins, so negations can re-include paths.
+func (g *GitIgnore) Ignored(rel string, isDir bool) bool {
+	ignored := false
+	for _, rule := range g.rules {
+		if rule.matches(rel, isDir) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+// matches reports whether the rule applies to rel
+func (r ignoreRule) matches(rel string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+
+	if r.base != "" {
+		if !strings.HasPrefix(rel, r.base+"/") {
+			return false
+		}
+		rel = strings.TrimPrefix(rel, r.base+"/")
+	}
+
+	if r.anchored {
+		return globMatch(r.pattern, rel)
+	}
+
+	// Unanchored patterns match the name at any depth
+	return globMatch(r.pattern, path.Base(rel))
+}
+
+// globMatch matches a slash-separated path against a pattern where "**"
+// matches any number of path segments and other segments use path.Match
+func globMatch(pattern, name string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			rest := pattern[1:]
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(rest, name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+		if ok, err := path.Match(pattern[0], name[0]); err != nil || !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// JSONChange represents a single leaf value that differs between two JSON documents
+type JSONChange struct {
+	Path   string
+	Before interface{} // nil if the value was added
+	After  interface{} // nil if the value was removed
+}
+
+// DiffJSON compares two decoded JSON values and returns the changed leaf paths,
+// sorted by path. Paths start at root and use dot notation for objects and
+// [i] for arrays, e.g. "filters.groups[0].rollout_percentage".
+func DiffJSON(root string, before, after interface{}) []JSONChange {
+	beforeLeaves := make(map[string]interface{})
+	afterLeaves := make(map[string]interface{})
+	flattenJSON(root, before, beforeLeaves)
+	flattenJSON(root, after, afterLeaves)
+
+	paths := make(map[string]struct{})
+	for path := range beforeLeaves {
+		paths[path] = struct{}{}
+	}
+	for path := range afterLeaves {
+		paths[path] = struct{}{}
+	}
+
+	var changes []JSONChange
+	for path := range paths {
+		b, inBefore := beforeLeaves[path]
+		a, inAfter := afterLeaves[path]
+		if inBefore && inAfter && reflect.DeepEqual(b, a) {
+			continue
+		}
+		changes = append(changes, JSONChange{Path: path, Before: b, After: a})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes
+}
+
+// flattenJSON walks a decoded JSON value and records every leaf under its path
+func flattenJSON(prefix string, value interface{}, out map[string]interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 && prefix != "" {
+			out[prefix] = v
+			return
+		}
+		for key, child := range v {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			flattenJSON(path, child, out)
+		}
+	case []interface{}:
+		if len(v) == 0 && prefix != "" {
+			out[prefix] = v
+			return
+		}
+		for i, child := range v {
+			flattenJSON(fmt.Sprintf("%s[%d]", prefix, i), child, out)
+		}
+	default:
+		if value == nil {
+			return
+		}
+		out[prefix] = value
+	}
+}
+
+// FormatJSONValue renders a decoded JSON value compactly for display
+func FormatJSONValue(value interface{}) string {
+	if value == nil {
+		return "(none)"
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(jsonBytes)
+}
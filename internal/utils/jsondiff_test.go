@@ -0,0 +1,73 @@
+package utils
+
+import "testing"
+
+func TestDiffJSON_Scalar(t *testing.T) {
+	changes := DiffJSON("active", false, true)
+
+	if len(changes) != 1 {
+		t.Fatalf("DiffJSON() returned %d changes, want 1", len(changes))
+	}
+	if changes[0].Path != "active" {
+		t.Errorf("DiffJSON() Path = %q, want %q", changes[0].Path, "active")
+	}
+	if changes[0].Before != false || changes[0].After != true {
+		t.Errorf("DiffJSON() = %v → %v, want false → true", changes[0].Before, changes[0].After)
+	}
+}
+
+func TestDiffJSON_NestedFilters(t *testing.T) {
+	before := map[string]interface{}{
+		"groups": []interface{}{
+			map[string]interface{}{"rollout_percentage": float64(50), "properties": []interface{}{}},
+		},
+	}
+	after := map[string]interface{}{
+		"groups": []interface{}{
+			map[string]interface{}{"rollout_percentage": float64(100), "properties": []interface{}{}},
+		},
+		"payloads": map[string]interface{}{"true": "on"},
+	}
+
+	changes := DiffJSON("filters", before, after)
+
+	if len(changes) != 2 {
+		t.Fatalf("DiffJSON() returned %d changes, want 2: %+v", len(changes), changes)
+	}
+	if changes[0].Path != "filters.groups[0].rollout_percentage" {
+		t.Errorf("changes[0].Path = %q", changes[0].Path)
+	}
+	if changes[0].Before != float64(50) || changes[0].After != float64(100) {
+		t.Errorf("changes[0] = %v → %v, want 50 → 100", changes[0].Before, changes[0].After)
+	}
+	if changes[1].Path != "filters.payloads.true" || changes[1].Before != nil {
+		t.Errorf("changes[1] = %+v, want added filters.payloads.true", changes[1])
+	}
+}
+
+func TestDiffJSON_NoChanges(t *testing.T) {
+	value := map[string]interface{}{"a": []interface{}{float64(1), "x"}}
+
+	if changes := DiffJSON("", value, value); len(changes) != 0 {
+		t.Errorf("DiffJSON() on equal values returned %d changes, want 0", len(changes))
+	}
+}
+
+func TestFormatJSONValue(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{nil, "(none)"},
+		{"beta", "beta"},
+		{true, "true"},
+		{float64(25), "25"},
+		{[]interface{}{"a"}, `["a"]`},
+	}
+
+	for _, tt := range tests {
+		if got := FormatJSONValue(tt.value); got != tt.want {
+			t.Errorf("FormatJSONValue(%v) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
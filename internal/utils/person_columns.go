@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// Person columns that aren't person properties
+const (
+	PersonColumnName     = "name"
+	PersonColumnCreated  = "created_at"
+	PersonColumnLastSeen = "last_seen_at"
+)
+
+// DefaultPersonColumns are the Persons list columns of projects without a
+// configured column set
+var DefaultPersonColumns = []string{"email", PersonColumnLastSeen}
+
+// SuggestedPersonColumns are offered as columns even if no listed person has them
+var SuggestedPersonColumns = []string{"email", "plan", "company", PersonColumnLastSeen, PersonColumnCreated}
+
+// PersonColumnLabel returns the header of a column
+func PersonColumnLabel(column string) string {
+	switch column {
+	case PersonColumnCreated:
+		return "created"
+	case PersonColumnLastSeen:
+		return "last seen"
+	default:
+		return column
+	}
+}
+
+// personColumnRaw returns a person's value of a column: a time for the
+// created and last seen columns, the property value otherwise
+func personColumnRaw(p client.Person, column string) interface{} {
+	switch column {
+	case PersonColumnName:
+		return p.Name
+	case PersonColumnCreated:
+		created, err := time.Parse(time.RFC3339, p.CreatedAt)
+		if err != nil {
+			return nil
+		}
+		return created
+	case PersonColumnLastSeen:
+		if p.LastSeenAt == nil {
+			return nil
+		}
+		return *p.LastSeenAt
+	default:
+		return p.Properties[column]
+	}
+}
+
+// PersonColumnValue formats a person's value of a column for display, times
+// relative to now, or "" if the person has none
+func PersonColumnValue(p client.Person, column string, now time.Time) string {
+	switch v := personColumnRaw(p, column).(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return FormatDuration(now.Sub(v)) + " ago"
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// PersonColumnLess reports whether a sorts before b by a column. Times and
+// numbers compare by value and other values alphabetically; persons without
+// a value sort last in either direction
+func PersonColumnLess(a, b client.Person, column string, desc bool) bool {
+	va, vb := personColumnRaw(a, column), personColumnRaw(b, column)
+	if isEmptyColumnValue(va) || isEmptyColumnValue(vb) {
+		return !isEmptyColumnValue(va) && isEmptyColumnValue(vb)
+	}
+
+	cmp := compareColumnValues(va, vb)
+	if desc {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+// isEmptyColumnValue reports whether a column value is missing or blank
+func isEmptyColumnValue(v interface{}) bool {
+	s, isString := v.(string)
+	return v == nil || (isString && strings.TrimSpace(s) == "")
+}
+
+// compareColumnValues compares two column values, returning -1, 0 or 1
+func compareColumnValues(a, b interface{}) int {
+	if ta, ok := a.(time.Time); ok {
+		if tb, ok := b.(time.Time); ok {
+			return ta.Compare(tb)
+		}
+	}
+
+	if na, ok := columnNumber(a); ok {
+		if nb, ok := columnNumber(b); ok {
+			switch {
+			case na < nb:
+				return -1
+			case na > nb:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+
+	return strings.Compare(strings.ToLower(fmt.Sprint(a)), strings.ToLower(fmt.Sprint(b)))
+}
+
+// columnNumber returns a column value as a number, parsing numeric strings
+func columnNumber(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
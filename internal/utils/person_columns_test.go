@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+func TestPersonColumnValue(t *testing.T) {
+	now := time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)
+	lastSeen := now.Add(-3 * time.Hour)
+	person := client.Person{
+		Name:       "Ada",
+		CreatedAt:  "2024-03-01T11:00:00.123Z",
+		LastSeenAt: &lastSeen,
+		Properties: map[string]interface{}{"email": "ada@example.com", "seats": float64(12), "beta": true},
+	}
+
+	tests := []struct {
+		column string
+		want   string
+	}{
+		{"email", "ada@example.com"},
+		{"seats", "12"},
+		{"beta", "true"},
+		{"plan", ""},
+		{PersonColumnName, "Ada"},
+		{PersonColumnLastSeen, "3h ago"},
+		{PersonColumnCreated, "4d ago"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.column, func(t *testing.T) {
+			if got := PersonColumnValue(person, tt.column, now); got != tt.want {
+				t.Errorf("PersonColumnValue(%q) = %q, want %q", tt.column, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPersonColumnLess(t *testing.T) {
+	persons := []client.Person{
+		{Name: "a", Properties: map[string]interface{}{"seats": "9"}},
+		{Name: "b", Properties: map[string]interface{}{}},
+		{Name: "c", Properties: map[string]interface{}{"seats": float64(10)}},
+		{Name: "d", Properties: map[string]interface{}{"seats": "2"}},
+	}
+
+	names := func(desc bool) string {
+		sorted := append([]client.Person(nil), persons...)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return PersonColumnLess(sorted[i], sorted[j], "seats", desc)
+		})
+		var s string
+		for _, p := range sorted {
+			s += p.Name
+		}
+		return s
+	}
+
+	// Numeric, not alphabetical, with the person without seats last
+	if got := names(false); got != "dacb" {
+		t.Errorf("ascending = %q, want %q", got, "dacb")
+	}
+	if got := names(true); got != "cadb" {
+		t.Errorf("descending = %q, want %q", got, "cadb")
+	}
+}
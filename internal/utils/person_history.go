@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// Person property update operations
+const (
+	PropertySet     = "$set"
+	PropertySetOnce = "$set_once"
+	PropertyUnset   = "$unset"
+)
+
+// PropertyChange is a change of a person property's value by an event
+type PropertyChange struct {
+	Value     interface{} // nil if unset
+	Operation string      // PropertySet, PropertySetOnce or PropertyUnset
+	Event     string
+	EventUUID string
+	Timestamp time.Time
+}
+
+// PropertyHistory is the timeline of a person property's values, oldest first
+type PropertyHistory struct {
+	Key     string
+	Changes []PropertyChange
+}
+
+// Latest returns the property's most recent change
+func (h PropertyHistory) Latest() PropertyChange {
+	return h.Changes[len(h.Changes)-1]
+}
+
+// BuildPropertyHistory reconstructs the timeline of each property from the
+// events that set or unset them, in any order. Setting a property to its
+// current value, $set_once of a property already set and unsetting a
+// property that was unset or never set aren't changes. Properties changed
+// most recently come first.
+func BuildPropertyHistory(updates []client.PersonPropertyUpdate) []PropertyHistory {
+	sorted := append([]client.PersonPropertyUpdate(nil), updates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	histories := make(map[string]*PropertyHistory)
+	record := func(update client.PersonPropertyUpdate, key, operation string, value interface{}) {
+		history, ok := histories[key]
+		if !ok {
+			if operation == PropertyUnset {
+				return
+			}
+			history = &PropertyHistory{Key: key}
+			histories[key] = history
+		}
+		if len(history.Changes) > 0 {
+			latest := history.Latest()
+			isSet := latest.Operation != PropertyUnset
+			switch {
+			case operation == PropertySetOnce && isSet:
+				return
+			case operation == PropertyUnset && !isSet:
+				return
+			case operation != PropertyUnset && isSet && reflect.DeepEqual(latest.Value, value):
+				return
+			}
+		}
+		history.Changes = append(history.Changes, PropertyChange{
+			Value:     value,
+			Operation: operation,
+			Event:     update.Event,
+			EventUUID: update.UUID,
+			Timestamp: update.Timestamp,
+		})
+	}
+
+	// Within an event, $set_once applies before $set overwrites, then $unset
+	for _, update := range sorted {
+		for _, key := range sortedKeys(update.SetOnce) {
+			record(update, key, PropertySetOnce, update.SetOnce[key])
+		}
+		for _, key := range sortedKeys(update.Set) {
+			record(update, key, PropertySet, update.Set[key])
+		}
+		for _, key := range update.Unset {
+			record(update, key, PropertyUnset, nil)
+		}
+	}
+
+	result := make([]PropertyHistory, 0, len(histories))
+	for _, history := range histories {
+		result = append(result, *history)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i].Latest().Timestamp, result[j].Latest().Timestamp
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
+
+// sortedKeys returns the keys of a property map in alphabetical order
+func sortedKeys(properties map[string]interface{}) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+func TestBuildPropertyHistory(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	// Newest first, as fetched
+	updates := []client.PersonPropertyUpdate{
+		{UUID: "5", Event: "trial_ended", Timestamp: day(5), Unset: []string{"trial", "never_set"}},
+		{UUID: "4", Event: "$identify", Timestamp: day(4), Set: map[string]interface{}{"plan": "pro"}, SetOnce: map[string]interface{}{"signup": "web"}},
+		{UUID: "3", Event: "upgraded", Timestamp: day(3), Set: map[string]interface{}{"plan": "pro"}},
+		{UUID: "2", Event: "$pageview", Timestamp: day(2), Set: map[string]interface{}{"plan": "free"}},
+		{UUID: "1", Event: "signed_up", Timestamp: day(1), Set: map[string]interface{}{"plan": "free", "trial": true}, SetOnce: map[string]interface{}{"signup": "ios"}},
+	}
+
+	histories := BuildPropertyHistory(updates)
+
+	byKey := make(map[string]PropertyHistory)
+	var order []string
+	for _, h := range histories {
+		byKey[h.Key] = h
+		order = append(order, h.Key)
+	}
+
+	// Most recently changed first, without never_set as unsetting it isn't a change
+	if want := []string{"trial", "plan", "signup"}; len(order) != len(want) || order[0] != want[0] || order[1] != want[1] || order[2] != want[2] {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+
+	plan := byKey["plan"].Changes
+	if len(plan) != 2 || plan[0].Value != "free" || plan[1].Value != "pro" || plan[1].Event != "upgraded" {
+		t.Errorf("plan changes = %+v, want free on signed_up then pro on upgraded", plan)
+	}
+
+	// $set_once doesn't overwrite
+	signup := byKey["signup"].Changes
+	if len(signup) != 1 || signup[0].Value != "ios" || signup[0].Operation != PropertySetOnce {
+		t.Errorf("signup changes = %+v, want ios set once", signup)
+	}
+
+	trial := byKey["trial"]
+	if len(trial.Changes) != 2 || trial.Latest().Operation != PropertyUnset || trial.Latest().Value != nil {
+		t.Errorf("trial changes = %+v, want set then unset", trial.Changes)
+	}
+}
@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxScanFileSize skips generated bundles and other large files
+	maxScanFileSize = 2 * 1024 * 1024
+
+	// maxReferenceTextLen truncates long source lines in reports
+	maxReferenceTextLen = 120
+)
+
+// CodeReference is a single occurrence of a flag key in a source file
+type CodeReference struct {
+	File string // slash-separated path relative to the scanned root
+	Line int
+	Text string // trimmed source line
+}
+
+// ScanFlagReferences walks the tree at root, respecting .gitignore files, and
+// returns the occurrences of each key. A key only matches as a whole token,
+// so "beta" does not match inside "beta-checkout". Every key is present in
+// the result, with an empty slice if it was not found.
+func ScanFlagReferences(root string, keys []string) (map[string][]CodeReference, error) {
+	refs := make(map[string][]CodeReference, len(keys))
+	for _, key := range keys {
+		refs[key] = []CodeReference{}
+	}
+
+	ignore := &GitIgnore{}
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == "." {
+			rel = ""
+		}
+
+		if d.IsDir() {
+			if d.Name() == ".git" || (rel != "" && ignore.Ignored(rel, true)) {
+				return filepath.SkipDir
+			}
+			return ignore.LoadDir(p, rel)
+		}
+
+		if !d.Type().IsRegular() || ignore.Ignored(rel, false) {
+			return nil
+		}
+
+		return scanFile(p, rel, refs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for key := range refs {
+		sort.SliceStable(refs[key], func(i, j int) bool {
+			a, b := refs[key][i], refs[key][j]
+			if a.File != b.File {
+				return a.File < b.File
+			}
+			return a.Line < b.Line
+		})
+	}
+
+	return refs, nil
+}
+
+// scanFile records every line of a text file that mentions one of the keys in refs
+func scanFile(p, rel string, refs map[string][]CodeReference) error {
+	info, err := os.Stat(p)
+	if err != nil || info.Size() > maxScanFileSize {
+		return nil
+	}
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil // unreadable files are skipped, not fatal
+	}
+
+	// Skip binary files
+	head := data
+	if len(head) > 8000 {
+		head = head[:8000]
+	}
+	if bytes.IndexByte(head, 0) >= 0 {
+		return nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), maxScanFileSize)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+
+		seen := make(map[string]bool)
+		for _, token := range flagKeyTokens(line) {
+			if _, ok := refs[token]; !ok || seen[token] {
+				continue
+			}
+			seen[token] = true
+
+			text := strings.TrimSpace(line)
+			if len(text) > maxReferenceTextLen {
+				text = text[:maxReferenceTextLen-3] + "..."
+			}
+			refs[token] = append(refs[token], CodeReference{File: rel, Line: lineNum, Text: text})
+		}
+	}
+
+	return nil
+}
+
+// flagKeyTokens splits a line into runs of characters allowed in flag keys
+// (letters, digits, '-' and '_')
+func flagKeyTokens(line string) []string {
+	var tokens []string
+	start := -1
+	for i := 0; i <= len(line); i++ {
+		isKeyChar := i < len(line) && isFlagKeyChar(line[i])
+		if isKeyChar && start < 0 {
+			start = i
+		} else if !isKeyChar && start >= 0 {
+			tokens = append(tokens, line[start:i])
+			start = -1
+		}
+	}
+	return tokens
+}
+
+func isFlagKeyChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '-' || c == '_'
+}
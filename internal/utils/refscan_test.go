@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGitIgnore_Ignored(t *testing.T) {
+	g := &GitIgnore{}
+	g.AddPattern("", "# comment")
+	g.AddPattern("", "node_modules/")
+	g.AddPattern("", "*.log")
+	g.AddPattern("", "!keep.log")
+	g.AddPattern("", "/dist")
+	g.AddPattern("", "docs/**/*.gen.md")
+	g.AddPattern("web", "build/")
+
+	tests := []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{"node_modules", true, true},
+		{"web/node_modules", true, true},
+		{"node_modules", false, false}, // dir-only pattern
+		{"debug.log", false, true},
+		{"src/debug.log", false, true},
+		{"keep.log", false, false},
+		{"dist", true, true},
+		{"src/dist", true, false}, // anchored to the root
+		{"docs/api/v1/flags.gen.md", false, true},
+		{"docs/flags.md", false, false},
+		{"web/build", true, true},
+		{"build", true, false}, // only applies below web/
+		{"src/main.go", false, false},
+	}
+
+	for _, tt := range tests {
+		if got := g.Ignored(tt.path, tt.isDir); got != tt.want {
+			t.Errorf("Ignored(%q, %v) = %v, want %v", tt.path, tt.isDir, got, tt.want)
+		}
+	}
+}
+
+func TestScanFlagReferences(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		".gitignore":            "vendor/\n",
+		"app/checkout.ts":       "if (posthog.isFeatureEnabled('new-checkout')) {\n  render()\n}\n// new-checkout-v2 is different\n",
+		"app/settings.go":       "const flag = \"beta_settings\"\nuse(\"new-checkout\")\n",
+		"vendor/lib/flags.js":   "new-checkout",
+		"app/nested/.gitignore": "*.snap\n",
+		"app/nested/a.snap":     "beta_settings",
+	}
+	for name, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	refs, err := ScanFlagReferences(root, []string{"new-checkout", "beta_settings", "dead-flag"})
+	if err != nil {
+		t.Fatalf("ScanFlagReferences() error = %v", err)
+	}
+
+	checkout := refs["new-checkout"]
+	if len(checkout) != 2 {
+		t.Fatalf("new-checkout references = %+v, want 2 (vendor ignored, v2 not matched)", checkout)
+	}
+	if checkout[0].File != "app/checkout.ts" || checkout[0].Line != 1 {
+		t.Errorf("first reference = %s:%d, want app/checkout.ts:1", checkout[0].File, checkout[0].Line)
+	}
+	if checkout[1].File != "app/settings.go" || checkout[1].Line != 2 {
+		t.Errorf("second reference = %s:%d, want app/settings.go:2", checkout[1].File, checkout[1].Line)
+	}
+
+	if len(refs["beta_settings"]) != 1 {
+		t.Errorf("beta_settings references = %+v, want 1 (nested .gitignore respected)", refs["beta_settings"])
+	}
+
+	if dead, ok := refs["dead-flag"]; !ok || len(dead) != 0 {
+		t.Errorf("dead-flag references = %+v (present %v), want empty", dead, ok)
+	}
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"sort"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// Session is a group of events sharing a $session_id, oldest first
+type Session struct {
+	ID     string // empty for events without a $session_id
+	Events []client.Event
+}
+
+// Start returns the time of the session's first event
+func (s Session) Start() time.Time {
+	return s.Events[0].Timestamp
+}
+
+// End returns the time of the session's last event
+func (s Session) End() time.Time {
+	return s.Events[len(s.Events)-1].Timestamp
+}
+
+// Duration returns the time between the session's first and last event
+func (s Session) Duration() time.Duration {
+	return s.End().Sub(s.Start())
+}
+
+// EntryURL returns the first $current_url seen in the session
+func (s Session) EntryURL() string {
+	for _, event := range s.Events {
+		if url := EventURL(event); url != "" {
+			return url
+		}
+	}
+	return ""
+}
+
+// ExitURL returns the last $current_url seen in the session
+func (s Session) ExitURL() string {
+	for i := len(s.Events) - 1; i >= 0; i-- {
+		if url := EventURL(s.Events[i]); url != "" {
+			return url
+		}
+	}
+	return ""
+}
+
+// EventURL returns an event's $current_url, or "" if it has none
+func EventURL(event client.Event) string {
+	url, _ := event.Properties["$current_url"].(string)
+	return url
+}
+
+// EventSessionID returns an event's $session_id, or "" if it has none
+func EventSessionID(event client.Event) string {
+	id, _ := event.Properties["$session_id"].(string)
+	return id
+}
+
+// GroupSessions groups events by $session_id. Sessions are returned most
+// recently started first, with their events oldest first. Events without a
+// $session_id are gathered in a final session with an empty ID.
+func GroupSessions(events []client.Event) []Session {
+	index := make(map[string]int)
+	var sessions []Session
+	var unattributed []client.Event
+
+	for _, event := range events {
+		id := EventSessionID(event)
+		if id == "" {
+			unattributed = append(unattributed, event)
+			continue
+		}
+		i, ok := index[id]
+		if !ok {
+			i = len(sessions)
+			index[id] = i
+			sessions = append(sessions, Session{ID: id})
+		}
+		sessions[i].Events = append(sessions[i].Events, event)
+	}
+
+	for i := range sessions {
+		sortEventsByTime(sessions[i].Events)
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].Start().After(sessions[j].Start())
+	})
+
+	if len(unattributed) > 0 {
+		sortEventsByTime(unattributed)
+		sessions = append(sessions, Session{Events: unattributed})
+	}
+	return sessions
+}
+
+// sortEventsByTime sorts events oldest first
+func sortEventsByTime(events []client.Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+}
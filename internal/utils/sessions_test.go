@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+func TestGroupSessions(t *testing.T) {
+	at := func(m int) time.Time { return time.Date(2024, 1, 15, 10, m, 0, 0, time.UTC) }
+	event := func(name, session, url string, minute int) client.Event {
+		props := map[string]interface{}{}
+		if session != "" {
+			props["$session_id"] = session
+		}
+		if url != "" {
+			props["$current_url"] = url
+		}
+		return client.Event{Event: name, Timestamp: at(minute), Properties: props}
+	}
+
+	// Newest first, as the events list is fetched
+	events := []client.Event{
+		event("$pageleave", "b", "https://example.com/checkout", 30),
+		event("clicked pay", "b", "", 25),
+		event("$identify", "", "", 22),
+		event("$pageview", "b", "https://example.com/cart", 20),
+		event("$pageview", "a", "https://example.com/pricing", 5),
+		event("$pageview", "a", "https://example.com/", 1),
+	}
+
+	sessions := GroupSessions(events)
+	if len(sessions) != 3 {
+		t.Fatalf("GroupSessions() returned %d sessions, want 2 plus unattributed", len(sessions))
+	}
+
+	latest := sessions[0]
+	if latest.ID != "b" || len(latest.Events) != 3 || latest.Events[0].Event != "$pageview" {
+		t.Errorf("first session = %s with %d events, want b with 3 events oldest first", latest.ID, len(latest.Events))
+	}
+	if latest.Duration() != 10*time.Minute {
+		t.Errorf("Duration() = %v, want 10m", latest.Duration())
+	}
+	if latest.EntryURL() != "https://example.com/cart" || latest.ExitURL() != "https://example.com/checkout" {
+		t.Errorf("entry/exit = %q/%q, want cart/checkout", latest.EntryURL(), latest.ExitURL())
+	}
+
+	if sessions[1].ID != "a" || !sessions[1].Start().Equal(at(1)) {
+		t.Errorf("second session = %s starting %v, want a starting %v", sessions[1].ID, sessions[1].Start(), at(1))
+	}
+	if last := sessions[2]; last.ID != "" || len(last.Events) != 1 {
+		t.Errorf("last session = %q with %d events, want unattributed $identify", last.ID, len(last.Events))
+	}
+
+	if got := GroupSessions(nil); len(got) != 0 {
+		t.Errorf("GroupSessions(nil) = %v, want none", got)
+	}
+}
@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// staticCohortBatchSize bounds the number of IDs sent per request
+const staticCohortBatchSize = 500
+
+// CohortQueryLimit is the LIMIT added to cohort queries without one, as
+// HogQL returns only 100 rows otherwise
+const CohortQueryLimit = 10000
+
+// trailingLimitPattern matches a LIMIT clause ending a query
+var trailingLimitPattern = regexp.MustCompile(`(?is)\blimit\s+\d+(\s*,\s*\d+)?(\s+offset\s+\d+)?\s*;?\s*$`)
+
+// LimitCohortQuery appends LIMIT CohortQueryLimit to a query that doesn't end
+// with a LIMIT, reporting whether it did. A result of CohortQueryLimit rows
+// from a limited query is then likely truncated.
+func LimitCohortQuery(query string) (string, bool) {
+	if trailingLimitPattern.MatchString(query) {
+		return query, false
+	}
+	query = strings.TrimRight(strings.TrimSpace(query), ";")
+	return fmt.Sprintf("%s\nLIMIT %d", query, CohortQueryLimit), true
+}
+
+// CohortUploader is the subset of the PostHog client needed to create static cohorts
+type CohortUploader interface {
+	CreateStaticCohort(ctx context.Context, name string) (*client.Cohort, error)
+	AddPersonsToStaticCohort(ctx context.Context, cohortID int, personUUIDs []string) error
+	ResolvePersonUUIDs(ctx context.Context, distinctIDs []string) ([]string, error)
+}
+
+// CohortMembers identifies the persons to put in a static cohort, by person
+// UUID and/or distinct ID
+type CohortMembers struct {
+	PersonIDs   []string
+	DistinctIDs []string
+}
+
+// Len returns the number of IDs, before resolving distinct IDs to persons
+func (c CohortMembers) Len() int {
+	return len(c.PersonIDs) + len(c.DistinctIDs)
+}
+
+// MembersFromQueryResult extracts persons from the person_id (preferred) or
+// distinct_id column of a HogQL query result
+func MembersFromQueryResult(result *client.QueryResult) (CohortMembers, error) {
+	var members CohortMembers
+	if result == nil {
+		return members, fmt.Errorf("no query result")
+	}
+
+	column, target := -1, &members.PersonIDs
+	for _, name := range []string{"person_id", "distinct_id"} {
+		for i, c := range result.Columns {
+			if strings.EqualFold(c, name) {
+				column = i
+				break
+			}
+		}
+		if column >= 0 {
+			if name == "distinct_id" {
+				target = &members.DistinctIDs
+			}
+			break
+		}
+	}
+	if column < 0 {
+		return members, fmt.Errorf("query result has no person_id or distinct_id column")
+	}
+
+	seen := make(map[string]bool)
+	for _, row := range result.Results {
+		if column >= len(row) || row[column] == nil {
+			continue
+		}
+		id := fmt.Sprintf("%v", row[column])
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		*target = append(*target, id)
+	}
+
+	return members, nil
+}
+
+// CreateStaticCohort creates a static cohort with the given members and
+// returns it along with the number of persons added. Distinct IDs are
+// resolved to persons first; IDs without a person are dropped. If adding
+// persons fails after the cohort was created, the cohort is still returned.
+func CreateStaticCohort(ctx context.Context, u CohortUploader, name string, members CohortMembers) (*client.Cohort, int, error) {
+	personIDs := append([]string{}, members.PersonIDs...)
+	for _, batch := range batchStrings(members.DistinctIDs, staticCohortBatchSize) {
+		resolved, err := u.ResolvePersonUUIDs(ctx, batch)
+		if err != nil {
+			return nil, 0, err
+		}
+		personIDs = append(personIDs, resolved...)
+	}
+	personIDs = dedupeStrings(personIDs)
+
+	if len(personIDs) == 0 {
+		return nil, 0, fmt.Errorf("no persons found for the given IDs")
+	}
+
+	cohort, err := u.CreateStaticCohort(ctx, name)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	added := 0
+	for _, batch := range batchStrings(personIDs, staticCohortBatchSize) {
+		if err := u.AddPersonsToStaticCohort(ctx, cohort.ID, batch); err != nil {
+			return cohort, added, fmt.Errorf("cohort %q created, but adding persons failed: %w", name, err)
+		}
+		added += len(batch)
+	}
+
+	return cohort, added, nil
+}
+
+// batchStrings splits values into consecutive batches of at most size
+func batchStrings(values []string, size int) [][]string {
+	var batches [][]string
+	for start := 0; start < len(values); start += size {
+		end := start + size
+		if end > len(values) {
+			end = len(values)
+		}
+		batches = append(batches, values[start:end])
+	}
+	return batches
+}
+
+// dedupeStrings removes duplicates, keeping the first occurrence
+func dedupeStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := values[:0]
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
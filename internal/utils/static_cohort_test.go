@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// mockUploader resolves distinct IDs as "person-<id>" and records additions
+type mockUploader struct {
+	created string
+	added   []string
+}
+
+func (m *mockUploader) CreateStaticCohort(ctx context.Context, name string) (*client.Cohort, error) {
+	m.created = name
+	return &client.Cohort{ID: 42, Name: name, IsStatic: true}, nil
+}
+
+func (m *mockUploader) AddPersonsToStaticCohort(ctx context.Context, cohortID int, personUUIDs []string) error {
+	m.added = append(m.added, personUUIDs...)
+	return nil
+}
+
+func (m *mockUploader) ResolvePersonUUIDs(ctx context.Context, distinctIDs []string) ([]string, error) {
+	uuids := make([]string, len(distinctIDs))
+	for i, id := range distinctIDs {
+		uuids[i] = "person-" + id
+	}
+	return uuids, nil
+}
+
+func TestMembersFromQueryResult(t *testing.T) {
+	result := &client.QueryResult{
+		Columns: []string{"event", "distinct_id"},
+		Results: [][]interface{}{
+			{"$pageview", "user-1"},
+			{"$pageview", "user-2"},
+			{"$autocapture", "user-1"},
+			{"$pageview", nil},
+		},
+	}
+
+	members, err := MembersFromQueryResult(result)
+	if err != nil {
+		t.Fatalf("MembersFromQueryResult() error = %v", err)
+	}
+	if want := []string{"user-1", "user-2"}; !reflect.DeepEqual(members.DistinctIDs, want) {
+		t.Errorf("DistinctIDs = %v, want %v", members.DistinctIDs, want)
+	}
+	if len(members.PersonIDs) != 0 {
+		t.Errorf("PersonIDs = %v, want none", members.PersonIDs)
+	}
+
+	if _, err := MembersFromQueryResult(&client.QueryResult{Columns: []string{"event"}}); err == nil {
+		t.Error("MembersFromQueryResult() without an ID column should fail")
+	}
+}
+
+func TestCreateStaticCohort(t *testing.T) {
+	var distinctIDs []string
+	for i := 0; i < staticCohortBatchSize+10; i++ {
+		distinctIDs = append(distinctIDs, fmt.Sprintf("user-%d", i))
+	}
+	members := CohortMembers{
+		PersonIDs:   []string{"person-user-0", "person-direct"},
+		DistinctIDs: distinctIDs,
+	}
+
+	m := &mockUploader{}
+	cohort, added, err := CreateStaticCohort(context.Background(), m, "Affected users", members)
+	if err != nil {
+		t.Fatalf("CreateStaticCohort() error = %v", err)
+	}
+	if cohort.ID != 42 || m.created != "Affected users" {
+		t.Errorf("CreateStaticCohort() created %q (#%d)", m.created, cohort.ID)
+	}
+	// user-0 resolves to a person that was also given directly
+	if want := len(distinctIDs) + 1; added != want || len(m.added) != want {
+		t.Errorf("CreateStaticCohort() added %d persons (%d sent), want %d", added, len(m.added), want)
+	}
+
+	if _, _, err := CreateStaticCohort(context.Background(), m, "Empty", CohortMembers{}); err == nil {
+		t.Error("CreateStaticCohort() without members should fail")
+	}
+}
+
+func TestLimitCohortQuery(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		want        string
+		wantLimited bool
+	}{
+		{
+			name:        "no limit",
+			query:       "SELECT DISTINCT distinct_id FROM events;",
+			want:        "SELECT DISTINCT distinct_id FROM events\nLIMIT 10000",
+			wantLimited: true,
+		},
+		{
+			name:        "limit in a subquery only",
+			query:       "SELECT person_id FROM (SELECT person_id FROM events LIMIT 5) WHERE 1",
+			want:        "SELECT person_id FROM (SELECT person_id FROM events LIMIT 5) WHERE 1\nLIMIT 10000",
+			wantLimited: true,
+		},
+		{
+			name:  "explicit limit",
+			query: "SELECT person_id FROM events limit 500 OFFSET 10 ",
+			want:  "SELECT person_id FROM events limit 500 OFFSET 10 ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, limited := LimitCohortQuery(tt.query)
+			if got != tt.want || limited != tt.wantLimited {
+				t.Errorf("LimitCohortQuery() = %q, %v; want %q, %v", got, limited, tt.want, tt.wantLimited)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// SurveyResponse is one respondent's answers, aligned with the survey's questions
+type SurveyResponse struct {
+	DistinctID string
+	Timestamp  time.Time
+	Answers    []interface{} // nil where a question was skipped
+}
+
+// SurveyAnswerCount is the number of responses giving an answer
+type SurveyAnswerCount struct {
+	Answer string
+	Count  int
+}
+
+// ParseSurveyResponses extracts the answers from "survey sent" events
+func ParseSurveyResponses(survey client.Survey, events []client.Event) []SurveyResponse {
+	responses := make([]SurveyResponse, 0, len(events))
+	for _, event := range events {
+		response := SurveyResponse{
+			DistinctID: event.DistinctID,
+			Timestamp:  event.Timestamp,
+			Answers:    make([]interface{}, len(survey.Questions)),
+		}
+		for i, question := range survey.Questions {
+			response.Answers[i] = surveyAnswer(event.Properties, question, i)
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+// surveyAnswer looks up the answer to a question. Answers are keyed by
+// question ID, or by position for older SDKs: $survey_response for the
+// first question, $survey_response_1 for the second and so on.
+func surveyAnswer(properties map[string]interface{}, question client.SurveyQuestion, index int) interface{} {
+	if question.ID != "" {
+		if answer, ok := properties["$survey_response_"+question.ID]; ok && answer != nil {
+			return answer
+		}
+	}
+	key := "$survey_response"
+	if index > 0 {
+		key = fmt.Sprintf("$survey_response_%d", index)
+	}
+	return properties[key]
+}
+
+// SurveyAnswerText formats an answer, joining multiple choices with "; "
+func SurveyAnswerText(answer interface{}) string {
+	switch v := answer.(type) {
+	case nil:
+		return ""
+	case []interface{}:
+		choices := make([]string, len(v))
+		for i, choice := range v {
+			choices[i] = FormatJSONValue(choice)
+		}
+		return strings.Join(choices, "; ")
+	default:
+		return FormatJSONValue(v)
+	}
+}
+
+// SurveyDistribution counts the answers to the rating or choice question at
+// index, along with the number of responses that answered it. Every rating
+// on the scale and every choice is listed, in order, even if never picked;
+// answers outside them (e.g. an "Other" free text choice) follow, most
+// common first.
+func SurveyDistribution(question client.SurveyQuestion, responses []SurveyResponse, index int) ([]SurveyAnswerCount, int) {
+	var expected []string
+	switch question.Type {
+	case client.SurveyQuestionRating:
+		// A 10 point scale is a 0-10 NPS question
+		first := 1
+		if question.Scale == 10 {
+			first = 0
+		}
+		for r := first; r <= question.Scale; r++ {
+			expected = append(expected, fmt.Sprintf("%d", r))
+		}
+	case client.SurveyQuestionSingleChoice, client.SurveyQuestionMultipleChoice:
+		expected = question.Choices
+	}
+
+	counts := make(map[string]int)
+	answered := 0
+	for _, response := range responses {
+		if index >= len(response.Answers) || response.Answers[index] == nil {
+			continue
+		}
+		answer := response.Answers[index]
+		if choices, ok := answer.([]interface{}); ok {
+			if len(choices) == 0 {
+				continue
+			}
+			for _, choice := range choices {
+				counts[FormatJSONValue(choice)]++
+			}
+		} else {
+			text := FormatJSONValue(answer)
+			if text == "" {
+				continue
+			}
+			counts[text]++
+		}
+		answered++
+	}
+
+	distribution := make([]SurveyAnswerCount, 0, len(counts))
+	seen := make(map[string]bool)
+	for _, answer := range expected {
+		distribution = append(distribution, SurveyAnswerCount{Answer: answer, Count: counts[answer]})
+		seen[answer] = true
+	}
+
+	var others []SurveyAnswerCount
+	for answer, count := range counts {
+		if !seen[answer] {
+			others = append(others, SurveyAnswerCount{Answer: answer, Count: count})
+		}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		if others[i].Count != others[j].Count {
+			return others[i].Count > others[j].Count
+		}
+		return others[i].Answer < others[j].Answer
+	})
+
+	return append(distribution, others...), answered
+}
+
+// SurveyResponseRows returns a CSV header and one row per response, with a
+// column per question
+func SurveyResponseRows(survey client.Survey, responses []SurveyResponse) ([]string, [][]string) {
+	header := []string{"timestamp", "distinct_id"}
+	for _, question := range survey.Questions {
+		header = append(header, question.Question)
+	}
+
+	rows := make([][]string, len(responses))
+	for i, response := range responses {
+		row := []string{response.Timestamp.UTC().Format(time.RFC3339), response.DistinctID}
+		for _, answer := range response.Answers {
+			row = append(row, SurveyAnswerText(answer))
+		}
+		rows[i] = row
+	}
+
+	return header, rows
+}
+
+// ExportSurveyResponsesCSV writes a survey's responses to a CSV file
+func ExportSurveyResponsesCSV(survey client.Survey, responses []SurveyResponse, filename string) error {
+	header, rows := SurveyResponseRows(survey, responses)
+	return WriteCSV(filename, header, rows)
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+func testSurvey() client.Survey {
+	return client.Survey{
+		ID: "s1",
+		Questions: []client.SurveyQuestion{
+			{ID: "q-rating", Type: client.SurveyQuestionRating, Question: "How likely?", Scale: 5},
+			{Type: client.SurveyQuestionMultipleChoice, Question: "Which features?", Choices: []string{"Charts", "Flags"}},
+			{ID: "q-open", Type: client.SurveyQuestionOpen, Question: "Anything else?"},
+		},
+	}
+}
+
+func TestParseSurveyResponses(t *testing.T) {
+	ts := time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)
+	events := []client.Event{
+		{
+			DistinctID: "u1",
+			Timestamp:  ts,
+			Properties: map[string]interface{}{
+				"$survey_response_q-rating": "4",
+				"$survey_response_1":        []interface{}{"Charts", "Flags"},
+				"$survey_response_q-open":   "Love it",
+			},
+		},
+		{
+			// Older SDKs key answers by position only
+			DistinctID: "u2",
+			Timestamp:  ts,
+			Properties: map[string]interface{}{
+				"$survey_response":   float64(2),
+				"$survey_response_1": []interface{}{"Dark mode"},
+			},
+		},
+	}
+
+	responses := ParseSurveyResponses(testSurvey(), events)
+
+	want := [][]interface{}{
+		{"4", []interface{}{"Charts", "Flags"}, "Love it"},
+		{float64(2), []interface{}{"Dark mode"}, nil},
+	}
+	for i, response := range responses {
+		if !reflect.DeepEqual(response.Answers, want[i]) {
+			t.Errorf("response %d answers = %v, want %v", i, response.Answers, want[i])
+		}
+	}
+
+	header, rows := SurveyResponseRows(testSurvey(), responses)
+	if want := []string{"timestamp", "distinct_id", "How likely?", "Which features?", "Anything else?"}; !reflect.DeepEqual(header, want) {
+		t.Errorf("header = %q, want %q", header, want)
+	}
+	if want := []string{"2024-03-05T14:30:00Z", "u1", "4", "Charts; Flags", "Love it"}; !reflect.DeepEqual(rows[0], want) {
+		t.Errorf("row = %q, want %q", rows[0], want)
+	}
+}
+
+func TestSurveyDistribution(t *testing.T) {
+	survey := testSurvey()
+	responses := []SurveyResponse{
+		{Answers: []interface{}{"4", []interface{}{"Charts", "Flags"}, nil}},
+		{Answers: []interface{}{float64(4), []interface{}{"Dark mode"}, nil}},
+		{Answers: []interface{}{"1", []interface{}{}, nil}},
+		{Answers: []interface{}{nil, nil, nil}},
+	}
+
+	got, answered := SurveyDistribution(survey.Questions[0], responses, 0)
+	want := []SurveyAnswerCount{{"1", 1}, {"2", 0}, {"3", 0}, {"4", 2}, {"5", 0}}
+	if !reflect.DeepEqual(got, want) || answered != 3 {
+		t.Errorf("rating distribution = %v (%d answered), want %v (3 answered)", got, answered, want)
+	}
+
+	got, answered = SurveyDistribution(survey.Questions[1], responses, 1)
+	want = []SurveyAnswerCount{{"Charts", 1}, {"Flags", 1}, {"Dark mode", 1}}
+	if !reflect.DeepEqual(got, want) || answered != 2 {
+		t.Errorf("choice distribution = %v (%d answered), want %v (2 answered)", got, answered, want)
+	}
+}
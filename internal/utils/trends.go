@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"sort"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+// otherBreakdown labels the sum of breakdown values beyond the top ones
+const otherBreakdown = "(other)"
+
+// TrendLine is one series of a trend chart, with a value per bucket
+type TrendLine struct {
+	Label  string
+	Values []float64
+	Total  float64
+}
+
+// TrendChart holds trend series aligned to a common set of buckets
+type TrendChart struct {
+	Buckets []time.Time
+	Lines   []TrendLine
+}
+
+// BuildTrendChart aligns trend points to the buckets of a window ending at
+// end, filling empty buckets with zero. Breakdown values beyond the
+// maxLines largest are summed into a single "(other)" line.
+func BuildTrendChart(points []client.TrendPoint, end time.Time, window time.Duration, interval client.TrendInterval, maxLines int) TrendChart {
+	step := interval.Step()
+	count := int(window / step)
+	if count < 1 {
+		count = 1
+	}
+
+	last := end.UTC().Truncate(step)
+	first := last.Add(-time.Duration(count-1) * step)
+
+	chart := TrendChart{Buckets: make([]time.Time, count)}
+	for i := range chart.Buckets {
+		chart.Buckets[i] = first.Add(time.Duration(i) * step)
+	}
+
+	// Sum points per breakdown value and bucket
+	values := make(map[string][]float64)
+	totals := make(map[string]float64)
+	for _, p := range points {
+		// Buckets may be offset from UTC by the project's timezone
+		idx := int(p.Bucket.Sub(first).Round(step) / step)
+		if idx < 0 || idx >= count {
+			continue
+		}
+		if values[p.Breakdown] == nil {
+			values[p.Breakdown] = make([]float64, count)
+		}
+		values[p.Breakdown][idx] += p.Count
+		totals[p.Breakdown] += p.Count
+	}
+
+	labels := make([]string, 0, len(values))
+	for label := range values {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if totals[labels[i]] != totals[labels[j]] {
+			return totals[labels[i]] > totals[labels[j]]
+		}
+		return labels[i] < labels[j]
+	})
+
+	var other *TrendLine
+	for i, label := range labels {
+		if maxLines > 0 && i >= maxLines {
+			if other == nil {
+				other = &TrendLine{Label: otherBreakdown, Values: make([]float64, count)}
+			}
+			for j, v := range values[label] {
+				other.Values[j] += v
+			}
+			other.Total += totals[label]
+			continue
+		}
+		chart.Lines = append(chart.Lines, TrendLine{Label: label, Values: values[label], Total: totals[label]})
+	}
+	if other != nil {
+		chart.Lines = append(chart.Lines, *other)
+	}
+
+	// An event with no occurrences still gets a flat line
+	if len(chart.Lines) == 0 {
+		chart.Lines = []TrendLine{{Values: make([]float64, count)}}
+	}
+
+	return chart
+}
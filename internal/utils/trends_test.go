@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aljazfarkas/lazyhog/internal/client"
+)
+
+func TestBuildTrendChart(t *testing.T) {
+	end := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	hour := func(h int) time.Time { return time.Date(2024, 1, 15, h, 0, 0, 0, time.UTC) }
+
+	points := []client.TrendPoint{
+		{Bucket: hour(10), Breakdown: "Chrome", Count: 5},
+		{Bucket: hour(9), Breakdown: "Chrome", Count: 3},
+		{Bucket: hour(9), Breakdown: "Safari", Count: 2},
+		{Bucket: hour(8), Breakdown: "Firefox", Count: 1},
+		{Bucket: hour(2), Breakdown: "Chrome", Count: 100}, // outside the window
+	}
+
+	chart := BuildTrendChart(points, end, 6*time.Hour, client.TrendHourly, 2)
+
+	if len(chart.Buckets) != 6 || !chart.Buckets[5].Equal(hour(10)) || !chart.Buckets[0].Equal(hour(5)) {
+		t.Fatalf("BuildTrendChart() buckets = %v, want 05:00..10:00", chart.Buckets)
+	}
+
+	if len(chart.Lines) != 3 {
+		t.Fatalf("BuildTrendChart() returned %d lines, want 2 plus other", len(chart.Lines))
+	}
+
+	chrome := chart.Lines[0]
+	if chrome.Label != "Chrome" || chrome.Total != 8 || chrome.Values[4] != 3 || chrome.Values[5] != 5 {
+		t.Errorf("first line = %+v, want Chrome with 3 at 09:00 and 5 at 10:00", chrome)
+	}
+	if other := chart.Lines[2]; other.Label != otherBreakdown || other.Total != 1 || other.Values[3] != 1 {
+		t.Errorf("last line = %+v, want Firefox folded into other", other)
+	}
+
+	empty := BuildTrendChart(nil, end, 24*time.Hour, client.TrendHourly, 5)
+	if len(empty.Lines) != 1 || len(empty.Lines[0].Values) != 24 {
+		t.Errorf("BuildTrendChart() without points = %+v, want one flat line of 24 buckets", empty.Lines)
+	}
+}